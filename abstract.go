@@ -0,0 +1,28 @@
+package talib
+
+// #include <stdlib.h>
+// #include "ta-lib/ta_libc.h"
+import "C"
+
+import "unsafe"
+
+// loadAbstract fills in the information about the function which is only available from the TA-Lib abstract interface.
+func (f *FuncInfo) loadAbstract() {
+	name := C.CString(f.Name)
+	defer C.free(unsafe.Pointer(name))
+
+	var handle *C.TA_FuncHandle
+	if C.TA_GetFuncHandle(name, &handle) != C.TA_SUCCESS {
+		return
+	}
+	var info *C.TA_FuncInfo
+	if C.TA_GetFuncInfo(handle, &info) == C.TA_SUCCESS {
+		f.Group = C.GoString(info.group)
+	}
+	for i := range f.Options {
+		var opt *C.TA_OptInputParameterInfo
+		if C.TA_GetOptInputParameterInfo(handle, C.uint(i), &opt) == C.TA_SUCCESS {
+			f.Options[i].Default = float64(opt.defaultValue)
+		}
+	}
+}
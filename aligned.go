@@ -0,0 +1,30 @@
+package talib
+
+import "math"
+
+// alignFloat shifts the nb elements at the start of out to begIdx, and fills the remainder of the first n elements with
+// NaN.
+func alignFloat(out []float64, n, begIdx, nb int) []float64 {
+	out = out[:n]
+	copy(out[begIdx:begIdx+nb], out[:nb])
+	for i := 0; i < begIdx; i++ {
+		out[i] = math.NaN()
+	}
+	for i := begIdx + nb; i < n; i++ {
+		out[i] = math.NaN()
+	}
+	return out
+}
+
+// alignInt shifts the nb elements at the start of out to begIdx, and fills the remainder of the first n elements with 0.
+func alignInt(out []int, n, begIdx, nb int) []int {
+	out = out[:n]
+	copy(out[begIdx:begIdx+nb], out[:nb])
+	for i := 0; i < begIdx; i++ {
+		out[i] = 0
+	}
+	for i := begIdx + nb; i < n; i++ {
+		out[i] = 0
+	}
+	return out
+}
@@ -0,0 +1,25 @@
+package talib_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/phemmer/talib"
+)
+
+func TestSmaAligned(t *testing.T) {
+	out, begIdx := talib.SmaAligned([]float64{1, 2, 3, 4, 5}, 3, nil)
+	expected := []float64{math.NaN(), math.NaN(), 2, 3, 4}
+	if begIdx != 2 {
+		t.Errorf("Expected begIdx 2 got %d.", begIdx)
+	}
+	if len(out) != len(expected) {
+		t.Fatalf("Expected %#v got %#v.", expected, out)
+	}
+	for i := range expected {
+		if out[i] != expected[i] && !(math.IsNaN(out[i]) && math.IsNaN(expected[i])) {
+			t.Errorf("Expected %#v got %#v.", expected, out)
+			break
+		}
+	}
+}
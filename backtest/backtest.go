@@ -0,0 +1,236 @@
+/*
+Package backtest simulates trading strategies over historical bars, using indicators from the talib package.
+
+A backtest runs a Strategy over each bar in turn. Orders placed by the strategy are filled from the following bar
+onwards, using the open, high and low of the bar to determine whether and at what price they fill. The result records the
+equity at the close of each bar and the round trip trades made.
+*/
+package backtest
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/phemmer/talib"
+	"github.com/phemmer/talib/metrics"
+)
+
+// Strategy is a trading strategy.
+type Strategy interface {
+	// Indicators is called once before the backtest with all of the bars, and returns the indicator values used by
+	// the strategy, keyed by name. Each slice must be aligned with the bars, such as those returned by the Aligned
+	// functions of talib. E.g.
+	//
+	//	rsi, _ := talib.RsiAligned(bars.Close, 14, nil)
+	//	return map[string][]float64{"rsi": rsi}, nil
+	//
+	// Strategies must only use the values up to the current bar, which are available through Context.
+	Indicators(bars talib.OHLCV) (map[string][]float64, error)
+	// OnBar is called at the close of each bar.
+	OnBar(ctx *Context)
+}
+
+// Config holds the parameters of a backtest.
+type Config struct {
+	// Cash is the starting cash.
+	Cash float64
+	// Commission is charged on every fill.
+	Commission Commission
+	// Slippage is the fraction of the price by which market and stop orders are filled worse than the trigger price,
+	// e.g. 0.001 for 0.1%.
+	Slippage float64
+}
+
+// Bar is a single price bar.
+type Bar struct {
+	Time                           time.Time
+	Open, High, Low, Close, Volume float64
+}
+
+// Context gives a strategy access to the state of the backtest at the current bar.
+type Context struct {
+	// Index is the position of the current bar.
+	Index int
+	// Bar is the current bar.
+	Bar Bar
+
+	bars       talib.OHLCV
+	indicators map[string][]float64
+	broker     *broker
+	err        error
+}
+
+// Indicator returns the value of the named indicator at the current bar, or NaN if there is no such indicator.
+func (c *Context) Indicator(name string) float64 {
+	return c.IndicatorAgo(name, 0)
+}
+
+// IndicatorAgo returns the value of the named indicator the given number of bars before the current one, or NaN if
+// there is no such value.
+func (c *Context) IndicatorAgo(name string, ago int) float64 {
+	v := c.indicators[name]
+	i := c.Index - ago
+	if i < 0 || i >= len(v) || ago < 0 {
+		return math.NaN()
+	}
+	return v[i]
+}
+
+// Bars returns the bars up to and including the current bar.
+func (c *Context) Bars() talib.OHLCV {
+	n := c.Index + 1
+	bars := c.bars
+	for _, col := range []*[]float64{&bars.Open, &bars.High, &bars.Low, &bars.Close, &bars.Volume} {
+		if len(*col) > 0 {
+			*col = (*col)[:n]
+		}
+	}
+	if len(bars.Time) > 0 {
+		bars.Time = bars.Time[:n]
+	}
+	return bars
+}
+
+// Position returns the current position. It is negative when short.
+func (c *Context) Position() float64 {
+	return c.broker.position
+}
+
+// Cash returns the current cash.
+func (c *Context) Cash() float64 {
+	return c.broker.cash
+}
+
+// Equity returns the value of the cash and position at the close of the current bar.
+func (c *Context) Equity() float64 {
+	return c.broker.cash + c.broker.position*c.Bar.Close
+}
+
+// PendingOrders returns the orders which have not yet been filled or cancelled.
+func (c *Context) PendingOrders() []*Order {
+	return append([]*Order(nil), c.broker.orders...)
+}
+
+// Submit submits an order, to be filled from the next bar onwards. Only the Side, Type, Quantity and Price of the
+// order are used.
+//
+// An invalid order stops the backtest, and Run returns the error.
+func (c *Context) Submit(o Order) *Order {
+	order, err := c.broker.submit(o, c.Index)
+	if err != nil && c.err == nil {
+		c.err = err
+	}
+	return order
+}
+
+// Buy submits a market order to buy qty.
+func (c *Context) Buy(qty float64) *Order {
+	return c.Submit(Order{Side: Buy, Type: Market, Quantity: qty})
+}
+
+// Sell submits a market order to sell qty.
+func (c *Context) Sell(qty float64) *Order {
+	return c.Submit(Order{Side: Sell, Type: Market, Quantity: qty})
+}
+
+// Close submits a market order to close the current position, if any.
+func (c *Context) Close() *Order {
+	switch p := c.broker.position; {
+	case p > 0:
+		return c.Sell(p)
+	case p < 0:
+		return c.Buy(-p)
+	}
+	return nil
+}
+
+// Cancel cancels a pending order.
+func (c *Context) Cancel(o *Order) {
+	c.broker.cancel(o)
+}
+
+// Result is the outcome of a backtest.
+type Result struct {
+	// Time is the time of each bar, if the bars had times.
+	Time []time.Time
+	// Equity is the value of the cash and position at the close of each bar.
+	Equity []float64
+	// Position is the position at the close of each bar.
+	Position []float64
+	// Trades are the completed round trip trades.
+	Trades []Trade
+	// Open is the trade still open at the end of the backtest, if any.
+	Open *Trade
+	// Commission is the total commission paid.
+	Commission float64
+}
+
+// Run runs the strategy over the bars, which must have Open, High, Low and Close columns.
+func Run(bars talib.OHLCV, strategy Strategy, cfg Config) (*Result, error) {
+	if err := bars.Validate(); err != nil {
+		return nil, err
+	}
+	n := bars.Len()
+	if len(bars.Open) != n || len(bars.High) != n || len(bars.Low) != n || len(bars.Close) != n {
+		return nil, fmt.Errorf("backtest: bars must have Open, High, Low and Close columns")
+	}
+
+	indicators, err := strategy.Indicators(bars)
+	if err != nil {
+		return nil, err
+	}
+	for name, v := range indicators {
+		if len(v) != n {
+			return nil, fmt.Errorf("backtest: indicator %s has length %d, expected %d", name, len(v), n)
+		}
+	}
+
+	b := newBroker(cfg)
+	ctx := &Context{bars: bars, indicators: indicators, broker: b}
+	res := &Result{
+		Time:     bars.Time,
+		Equity:   make([]float64, n),
+		Position: make([]float64, n),
+	}
+	for i := 0; i < n; i++ {
+		bar := Bar{Open: bars.Open[i], High: bars.High[i], Low: bars.Low[i], Close: bars.Close[i]}
+		if len(bars.Time) > 0 {
+			bar.Time = bars.Time[i]
+		}
+		if len(bars.Volume) > 0 {
+			bar.Volume = bars.Volume[i]
+		}
+		ctx.Index, ctx.Bar = i, bar
+
+		b.process(i, bar.Time, bar.Open, bar.High, bar.Low)
+		strategy.OnBar(ctx)
+		if ctx.err != nil {
+			return nil, ctx.err
+		}
+		res.Equity[i] = ctx.Equity()
+		res.Position[i] = b.position
+	}
+
+	res.Trades = b.trades
+	if b.trade != nil {
+		open := *b.trade
+		res.Open = &open
+	}
+	for _, t := range b.trades {
+		res.Commission += t.Commission
+	}
+	if res.Open != nil {
+		res.Commission += res.Open.Commission
+	}
+	return res, nil
+}
+
+// Metrics returns the performance metrics of the backtest.
+func (r *Result) Metrics(cfg metrics.Config) metrics.Report {
+	pnl := make([]float64, len(r.Trades))
+	for i, t := range r.Trades {
+		pnl[i] = t.PnL
+	}
+	return metrics.Compute(r.Equity, r.Position, pnl, cfg)
+}
@@ -0,0 +1,114 @@
+package backtest_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/phemmer/talib"
+	"github.com/phemmer/talib/backtest"
+)
+
+// thresholdStrategy buys when the signal indicator is above 0, and sells when it is below 0.
+type thresholdStrategy struct {
+	signal []float64
+}
+
+func (s *thresholdStrategy) Indicators(bars talib.OHLCV) (map[string][]float64, error) {
+	return map[string][]float64{"signal": s.signal}, nil
+}
+
+func (s *thresholdStrategy) OnBar(ctx *backtest.Context) {
+	switch v := ctx.Indicator("signal"); {
+	case v > 0 && ctx.Position() == 0:
+		ctx.Buy(10)
+	case v < 0 && ctx.Position() > 0:
+		ctx.Close()
+	}
+}
+
+var testBars = talib.OHLCV{
+	Open:  []float64{10, 11, 12, 13, 12, 11},
+	High:  []float64{11, 12, 13, 14, 13, 12},
+	Low:   []float64{9, 10, 11, 12, 11, 10},
+	Close: []float64{10.5, 11.5, 12.5, 12.5, 11.5, 10.5},
+}
+
+func TestRun(t *testing.T) {
+	strategy := &thresholdStrategy{signal: []float64{1, 0, 0, -1, 0, 0}}
+	res, err := backtest.Run(testBars, strategy, backtest.Config{Cash: 1000, Commission: backtest.Commission{PerUnit: 0.1}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Bought 10 at the open of bar 1 (11), sold at the open of bar 4 (12), with 1 commission on each fill.
+	expectedEquity := []float64{1000, 1000 - 110 - 1 + 115, 1000 - 111 + 125, 1000 - 111 + 125, 1000 - 111 + 120 - 1, 1008}
+	if !reflect.DeepEqual(expectedEquity, res.Equity) {
+		t.Errorf("Expected %#v got %#v.", expectedEquity, res.Equity)
+	}
+	if len(res.Trades) != 1 {
+		t.Fatalf("Expected 1 trade got %#v.", res.Trades)
+	}
+	tr := res.Trades[0]
+	if !tr.Long || tr.EntryIndex != 1 || tr.ExitIndex != 4 || tr.EntryPrice != 11 || tr.ExitPrice != 12 || math.Abs(tr.PnL-8) > 1e-9 {
+		t.Errorf("Unexpected trade %#v.", tr)
+	}
+}
+
+type limitStrategy struct{}
+
+func (limitStrategy) Indicators(bars talib.OHLCV) (map[string][]float64, error) { return nil, nil }
+
+func (limitStrategy) OnBar(ctx *backtest.Context) {
+	if ctx.Index == 0 {
+		ctx.Submit(backtest.Order{Side: backtest.Sell, Type: backtest.Limit, Quantity: 1, Price: 13.5})
+		ctx.Submit(backtest.Order{Side: backtest.Buy, Type: backtest.Stop, Quantity: 1, Price: 11.5})
+	}
+}
+
+func TestRunLimitStop(t *testing.T) {
+	res, err := backtest.Run(testBars, limitStrategy{}, backtest.Config{Cash: 100, Slippage: 0.01})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The stop buy triggers at bar 1 at 11.5 plus slippage, and the limit sell fills at 13.5 on bar 3.
+	if len(res.Trades) != 1 || res.Trades[0].EntryIndex != 1 || res.Trades[0].ExitIndex != 3 {
+		t.Fatalf("Unexpected trades %#v.", res.Trades)
+	}
+	if pnl := 13.5 - 11.5*1.01; math.Abs(res.Trades[0].PnL-pnl) > 1e-9 {
+		t.Errorf("Expected PnL %v got %v.", pnl, res.Trades[0].PnL)
+	}
+}
+
+// scriptStrategy submits the orders for each bar.
+type scriptStrategy map[int][]backtest.Order
+
+func (scriptStrategy) Indicators(bars talib.OHLCV) (map[string][]float64, error) { return nil, nil }
+
+func (s scriptStrategy) OnBar(ctx *backtest.Context) {
+	for _, o := range s[ctx.Index] {
+		ctx.Submit(o)
+	}
+}
+
+func TestRunFractionalExits(t *testing.T) {
+	buy := func(qty float64) backtest.Order { return backtest.Order{Side: backtest.Buy, Quantity: qty} }
+	sell := func(qty float64) backtest.Order { return backtest.Order{Side: backtest.Sell, Quantity: qty} }
+	tests := []scriptStrategy{
+		// 0.3 - 0.1 - 0.2 leaves a residual order quantity.
+		{0: {buy(0.3)}, 1: {sell(0.1)}, 2: {sell(0.2)}},
+		// 0.1 + 0.1 + 0.1 - 0.3 leaves a residual position.
+		{0: {buy(0.1), buy(0.1), buy(0.1)}, 2: {sell(0.3)}},
+	}
+	for i, strategy := range tests {
+		res, err := backtest.Run(testBars, strategy, backtest.Config{Cash: 100})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(res.Trades) != 1 || res.Trades[0].ExitIndex != 3 || math.Abs(res.Trades[0].Quantity-0.3) > 1e-9 {
+			t.Errorf("%d: expected 1 closed trade of 0.3 got %#v.", i, res.Trades)
+		}
+		if res.Open != nil || res.Position[len(res.Position)-1] != 0 {
+			t.Errorf("%d: expected no position got %v, %#v.", i, res.Position, res.Open)
+		}
+	}
+}
@@ -0,0 +1,262 @@
+package backtest
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// Side is the direction of an order.
+type Side int
+
+const (
+	Buy Side = iota
+	Sell
+)
+
+func (s Side) String() string {
+	if s == Buy {
+		return "buy"
+	}
+	return "sell"
+}
+
+// OrderType is the type of an order.
+type OrderType int
+
+const (
+	// Market orders are filled at the open of the next bar.
+	Market OrderType = iota
+	// Limit orders are filled at Price or better.
+	Limit
+	// Stop orders become market orders once the price reaches Price.
+	Stop
+)
+
+func (t OrderType) String() string {
+	switch t {
+	case Market:
+		return "market"
+	case Limit:
+		return "limit"
+	case Stop:
+		return "stop"
+	}
+	return fmt.Sprintf("OrderType(%d)", int(t))
+}
+
+// OrderStatus is the state of an order.
+type OrderStatus int
+
+const (
+	Pending OrderStatus = iota
+	Filled
+	Cancelled
+)
+
+// Order is an order submitted to the broker.
+type Order struct {
+	ID       int
+	Side     Side
+	Type     OrderType
+	Quantity float64
+	// Price is the limit price for Limit orders, and the trigger price for Stop orders.
+	Price float64
+
+	Status OrderStatus
+	// Index is the bar at which the order was submitted.
+	Index int
+	// FillIndex, FillPrice and Commission are set once the order is filled.
+	FillIndex  int
+	FillPrice  float64
+	Commission float64
+}
+
+// Trade is a round trip from a flat position to a flat position.
+//
+// If an order reverses the position, the trade is closed and a new one opened with the remaining quantity.
+type Trade struct {
+	// Long is true for trades which bought first.
+	Long bool
+	// Quantity is the total quantity bought (for long trades) or sold (for short trades).
+	Quantity float64
+	// EntryIndex and ExitIndex are the bars of the first and last fill of the trade.
+	EntryIndex, ExitIndex int
+	EntryTime, ExitTime   time.Time
+	// EntryPrice and ExitPrice are the average prices of the fills opening and closing the trade.
+	EntryPrice, ExitPrice float64
+	// Commission is the total commission paid on the fills of the trade.
+	Commission float64
+	// PnL is the profit or loss of the trade, after commission.
+	PnL float64
+}
+
+// Commission is the cost of filling an order. The cost of a fill is PerUnit * quantity + Percent * quantity * price,
+// but no less than Minimum.
+type Commission struct {
+	PerUnit float64
+	Percent float64
+	Minimum float64
+}
+
+func (c Commission) cost(qty, price float64) float64 {
+	return math.Max(c.PerUnit*qty+c.Percent*qty*price, c.Minimum)
+}
+
+// broker simulates the execution of orders, and tracks the resulting position.
+type broker struct {
+	cfg    Config
+	orders []*Order
+	nextID int
+
+	cash     float64
+	position float64
+	avgPrice float64
+
+	trade  *Trade
+	exits  float64 // quantity closed in the current trade
+	trades []Trade
+}
+
+func newBroker(cfg Config) *broker {
+	return &broker{cfg: cfg, cash: cfg.Cash}
+}
+
+func (b *broker) submit(o Order, index int) (*Order, error) {
+	if o.Quantity <= 0 || math.IsNaN(o.Quantity) || math.IsInf(o.Quantity, 0) {
+		return nil, fmt.Errorf("backtest: invalid order quantity %v", o.Quantity)
+	}
+	if o.Type != Market && (o.Price <= 0 || math.IsNaN(o.Price)) {
+		return nil, fmt.Errorf("backtest: invalid %s order price %v", o.Type, o.Price)
+	}
+	b.nextID++
+	o.ID = b.nextID
+	o.Status = Pending
+	o.Index = index
+	b.orders = append(b.orders, &o)
+	return &o, nil
+}
+
+// fillPrice returns the price at which an order would be filled during the bar, if at all.
+func (b *broker) fillPrice(o *Order, open, high, low float64) (float64, bool) {
+	slip := 1 + b.cfg.Slippage
+	if o.Side == Sell {
+		slip = 1 - b.cfg.Slippage
+	}
+	switch o.Type {
+	case Market:
+		return open * slip, true
+	case Limit:
+		if o.Side == Buy {
+			if open <= o.Price {
+				return open, true
+			}
+			return o.Price, low <= o.Price
+		}
+		if open >= o.Price {
+			return open, true
+		}
+		return o.Price, high >= o.Price
+	case Stop:
+		if o.Side == Buy {
+			if open >= o.Price {
+				return open * slip, true
+			}
+			return o.Price * slip, high >= o.Price
+		}
+		if open <= o.Price {
+			return open * slip, true
+		}
+		return o.Price * slip, low <= o.Price
+	}
+	return 0, false
+}
+
+// process fills the pending orders which are triggered by the bar.
+func (b *broker) process(index int, t time.Time, open, high, low float64) {
+	pending := b.orders[:0]
+	for _, o := range b.orders {
+		if o.Index >= index {
+			pending = append(pending, o)
+			continue
+		}
+		price, ok := b.fillPrice(o, open, high, low)
+		if !ok {
+			pending = append(pending, o)
+			continue
+		}
+		o.Status = Filled
+		o.FillIndex = index
+		o.FillPrice = price
+		o.Commission = b.cfg.Commission.cost(o.Quantity, price)
+		b.fill(o, t)
+	}
+	b.orders = pending
+}
+
+// fill applies a filled order to the cash and position.
+func (b *broker) fill(o *Order, t time.Time) {
+	qty := o.Quantity
+	if o.Side == Sell {
+		qty = -qty
+	}
+	b.cash -= qty*o.FillPrice + o.Commission
+
+	remaining := qty
+	commission := o.Commission
+	// Quantities within eps of zero are rounding error from fractional fills, and are treated as zero.
+	eps := 1e-9 * math.Abs(qty)
+	if b.position != 0 && (b.position > 0) != (qty > 0) {
+		// Reduce the existing position.
+		closing := math.Min(math.Abs(qty), math.Abs(b.position))
+		if qty < 0 {
+			closing = -closing
+		}
+		share := commission * math.Abs(closing/qty)
+		tr := b.trade
+		tr.ExitPrice = (tr.ExitPrice*b.exits + o.FillPrice*math.Abs(closing)) / (b.exits + math.Abs(closing))
+		b.exits += math.Abs(closing)
+		tr.ExitIndex = o.FillIndex
+		tr.ExitTime = t
+		tr.Commission += share
+		tr.PnL += -closing*(o.FillPrice-b.avgPrice) - share
+		b.position += closing
+		remaining -= closing
+		commission -= share
+		if math.Abs(b.position) <= eps {
+			b.position = 0
+		}
+		if b.position == 0 {
+			b.trades = append(b.trades, *tr)
+			b.trade = nil
+			b.exits = 0
+		}
+	}
+	if math.Abs(remaining) <= eps {
+		return
+	}
+
+	// Open or increase the position.
+	if b.trade == nil {
+		b.trade = &Trade{Long: remaining > 0, EntryIndex: o.FillIndex, EntryTime: t}
+	}
+	tr := b.trade
+	tr.EntryPrice = (tr.EntryPrice*tr.Quantity + o.FillPrice*math.Abs(remaining)) / (tr.Quantity + math.Abs(remaining))
+	tr.Quantity += math.Abs(remaining)
+	tr.ExitIndex = o.FillIndex
+	tr.ExitTime = t
+	tr.Commission += commission
+	tr.PnL -= commission
+	b.avgPrice = (b.avgPrice*math.Abs(b.position) + o.FillPrice*math.Abs(remaining)) / (math.Abs(b.position) + math.Abs(remaining))
+	b.position += remaining
+}
+
+func (b *broker) cancel(o *Order) {
+	for i, p := range b.orders {
+		if p == o {
+			o.Status = Cancelled
+			b.orders = append(b.orders[:i], b.orders[i+1:]...)
+			return
+		}
+	}
+}
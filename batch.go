@@ -0,0 +1,218 @@
+package talib
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"sync"
+)
+
+// Indicator is a function call computed by a Batch.
+type Indicator struct {
+	// Name identifies the indicator in the results, e.g. "rsi14".
+	Name string
+	// Func is the TA-Lib or Go name of the function, e.g. "RSI".
+	Func string
+	// Options are the option values, in the order of FuncInfo.Options. If nil, the defaults are used.
+	Options []float64
+	// Inputs are the names of the OHLCV columns ("open", "high", "low", "close" or "volume") used for the inputs, in
+	// the order of FuncInfo.Inputs. If nil, each input uses the column of the same name, or "close" if there is none.
+	Inputs []string
+}
+
+// BatchResult holds the indicators computed for one symbol.
+type BatchResult struct {
+	// Outputs holds the outputs of each indicator, keyed by indicator name, in the order of FuncInfo.Outputs. The
+	// outputs are aligned with the bars, as with the Aligned functions.
+	Outputs map[string][][]float64
+	// Err is the error which prevented the indicators from being computed, if any.
+	Err error
+
+	buffers map[string][][]float64
+}
+
+// Batch computes the same indicators for many symbols concurrently.
+//
+// A Batch reuses the output slices of the previous run for each symbol, so the results of a run must not be used once
+// the next run starts. Runs of the same Batch are serialized.
+type Batch struct {
+	Indicators []Indicator
+	// Workers is the number of symbols computed concurrently. If 0, the number of CPUs is used.
+	Workers int
+
+	mu      sync.Mutex
+	results map[string]*BatchResult
+}
+
+// errPending is the error of a result which has not been computed yet.
+var errPending = errors.New("talib: not computed")
+
+// batchIndicator is an Indicator resolved to a function and its arguments.
+type batchIndicator struct {
+	name    string
+	f       *FuncInfo
+	options []float64
+	inputs  []string
+}
+
+func (b *Batch) resolve() ([]batchIndicator, error) {
+	inds := make([]batchIndicator, len(b.Indicators))
+	seen := make(map[string]bool, len(b.Indicators))
+	for i, ind := range b.Indicators {
+		if seen[ind.Name] {
+			return nil, fmt.Errorf("talib: duplicate indicator name %q", ind.Name)
+		}
+		seen[ind.Name] = true
+
+		f := LookupFunction(ind.Func)
+		if f == nil {
+			return nil, fmt.Errorf("talib: indicator %s: unknown function %q", ind.Name, ind.Func)
+		}
+		bi := batchIndicator{name: ind.Name, f: f, options: ind.Options, inputs: ind.Inputs}
+		if bi.options == nil {
+			bi.options = f.Defaults()
+		}
+		if bi.inputs == nil {
+			bi.inputs = make([]string, len(f.Inputs))
+			for j, in := range f.Inputs {
+				bi.inputs[j] = in
+				if _, ok := (OHLCV{}).column(in); !ok {
+					bi.inputs[j] = "close"
+				}
+			}
+		}
+		if len(bi.inputs) != len(f.Inputs) {
+			return nil, fmt.Errorf("talib: indicator %s: %s takes %d inputs, got %d", ind.Name, f.Name, len(f.Inputs), len(bi.inputs))
+		}
+		for _, in := range bi.inputs {
+			if _, ok := (OHLCV{}).column(in); !ok {
+				return nil, fmt.Errorf("talib: indicator %s: unknown column %q", ind.Name, in)
+			}
+		}
+		inds[i] = bi
+	}
+	return inds, nil
+}
+
+// Run computes the indicators for each symbol's bars, and returns the results keyed by symbol.
+//
+// An error computing the indicators for a symbol is recorded in its result, rather than stopping the batch. An error
+// is only returned if the indicators are invalid, or ctx is cancelled, in which case the symbols not yet computed
+// have the context's error as theirs.
+func (b *Batch) Run(ctx context.Context, bars map[string]OHLCV) (map[string]*BatchResult, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	inds, err := b.resolve()
+	if err != nil {
+		return nil, err
+	}
+
+	prev := b.results
+	b.results = make(map[string]*BatchResult, len(bars))
+	for sym := range bars {
+		res := prev[sym]
+		if res == nil {
+			res = &BatchResult{
+				Outputs: make(map[string][][]float64, len(inds)),
+				buffers: make(map[string][][]float64, len(inds)),
+			}
+		}
+		for name := range res.Outputs {
+			delete(res.Outputs, name)
+		}
+		res.Err = errPending
+		b.results[sym] = res
+	}
+
+	workers := b.Workers
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+	jobs := make(chan string)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for sym := range jobs {
+				res := b.results[sym]
+				res.Err = res.compute(bars[sym], inds)
+				if res.Err != nil {
+					res.Err = fmt.Errorf("talib: %s: %v", sym, res.Err)
+				}
+			}
+		}()
+	}
+
+feed:
+	for sym := range bars {
+		if err = ctx.Err(); err != nil {
+			break
+		}
+		select {
+		case jobs <- sym:
+		case <-ctx.Done():
+			err = ctx.Err()
+			break feed
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	if err != nil {
+		for _, res := range b.results {
+			if res.Err == errPending {
+				res.Err = err
+			}
+		}
+	}
+	return b.results, err
+}
+
+// compute computes the indicators for the bars into the result's outputs.
+func (r *BatchResult) compute(bars OHLCV, inds []batchIndicator) (err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			err = fmt.Errorf("%v", p)
+		}
+	}()
+
+	if err := bars.Validate(); err != nil {
+		return err
+	}
+	n := bars.Len()
+
+	for _, ind := range inds {
+		inputs := make([][]float64, len(ind.inputs))
+		for i, name := range ind.inputs {
+			inputs[i], _ = bars.column(name)
+			if len(inputs[i]) == 0 {
+				return fmt.Errorf("%s: bars have no %s column", ind.name, name)
+			}
+		}
+
+		buffers := r.buffers[ind.name]
+		if len(buffers) != len(ind.f.Outputs) {
+			buffers = make([][]float64, len(ind.f.Outputs))
+		}
+		for i := range buffers {
+			if cap(buffers[i]) < n {
+				buffers[i] = make([]float64, n)
+			}
+			buffers[i] = buffers[i][:n]
+		}
+
+		outputs, begIdx, err := ind.f.CallInto(inputs, ind.options, buffers)
+		if err != nil {
+			return fmt.Errorf("%s: %v", ind.name, err)
+		}
+		for i, out := range outputs {
+			buffers[i] = alignFloat(buffers[i], n, begIdx, len(out))
+		}
+		r.buffers[ind.name] = buffers
+		r.Outputs[ind.name] = buffers
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package talib_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/phemmer/talib"
+)
+
+func TestBatch(t *testing.T) {
+	close := []float64{1, 3, 2, 4, 3, 5, 4, 6, 5, 7, 6, 8}
+	high := []float64{2, 4, 3, 5, 4, 6, 5, 7, 6, 8, 7, 9}
+	low := []float64{0, 2, 1, 3, 2, 4, 3, 5, 4, 6, 5, 7}
+	bars := map[string]talib.OHLCV{
+		"good": {High: high, Low: low, Close: close},
+		"bad":  {High: high[:5], Low: low, Close: close},
+	}
+	b := &talib.Batch{Indicators: []talib.Indicator{
+		{Name: "rsi", Func: "RSI", Options: []float64{4}},
+		{Name: "atr", Func: "Atr", Options: []float64{3}},
+	}}
+
+	for run := 0; run < 2; run++ {
+		results, err := b.Run(context.Background(), bars)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if results["bad"].Err == nil {
+			t.Errorf("Expected an error for mismatched columns.")
+		}
+
+		good := results["good"]
+		if good.Err != nil {
+			t.Fatal(good.Err)
+		}
+		rsi, _ := talib.RsiAligned(close, 4, nil)
+		atr, _ := talib.AtrAligned(high, low, close, 3, nil)
+		if !reflect.DeepEqual(rsi[4:], good.Outputs["rsi"][0][4:]) {
+			t.Errorf("Expected %#v got %#v.", rsi, good.Outputs["rsi"][0])
+		}
+		if !reflect.DeepEqual(atr[3:], good.Outputs["atr"][0][3:]) {
+			t.Errorf("Expected %#v got %#v.", atr, good.Outputs["atr"][0])
+		}
+	}
+}
+
+func TestBatchCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	b := &talib.Batch{Indicators: []talib.Indicator{{Name: "sma", Func: "SMA"}}}
+	results, err := b.Run(ctx, map[string]talib.OHLCV{"a": {Close: []float64{1, 2, 3}}})
+	if err != context.Canceled {
+		t.Errorf("Expected %#v got %#v.", context.Canceled, err)
+	}
+	if r := results["a"]; r.Err != context.Canceled {
+		t.Errorf("Expected %#v got %#v.", context.Canceled, r.Err)
+	}
+}
@@ -0,0 +1,280 @@
+package talib
+
+import "sort"
+
+// Pattern is a candlestick pattern recognized by one of the Cdl functions.
+type Pattern int
+
+// The patterns, in the same order as the Cdl functions.
+const (
+	Pattern2Crows Pattern = iota
+	Pattern3BlackCrows
+	Pattern3Inside
+	Pattern3LineStrike
+	Pattern3Outside
+	Pattern3StarsInSouth
+	Pattern3WhiteSoldiers
+	PatternAbandonedBaby
+	PatternAdvanceBlock
+	PatternBeltHold
+	PatternBreakaway
+	PatternClosingMarubozu
+	PatternConcealBabySwallow
+	PatternCounterattack
+	PatternDarkCloudCover
+	PatternDoji
+	PatternDojiStar
+	PatternDragonflyDoji
+	PatternEngulfing
+	PatternEveningDojiStar
+	PatternEveningStar
+	PatternGapSideSideWhite
+	PatternGravestoneDoji
+	PatternHammer
+	PatternHangingMan
+	PatternHarami
+	PatternHaramiCross
+	PatternHighWave
+	PatternHikkake
+	PatternHikkakeMod
+	PatternHomingPigeon
+	PatternIdentical3Crows
+	PatternInNeck
+	PatternInvertedHammer
+	PatternKicking
+	PatternKickingByLength
+	PatternLadderBottom
+	PatternLongLeggedDoji
+	PatternLongLine
+	PatternMarubozu
+	PatternMatchingLow
+	PatternMatHold
+	PatternMorningDojiStar
+	PatternMorningStar
+	PatternOnNeck
+	PatternPiercing
+	PatternRickshawMan
+	PatternRiseFall3Methods
+	PatternSeparatingLines
+	PatternShootingStar
+	PatternShortLine
+	PatternSpinningTop
+	PatternStalledPattern
+	PatternStickSandwich
+	PatternTakuri
+	PatternTasukiGap
+	PatternThrusting
+	PatternTristar
+	PatternUnique3River
+	PatternUpsideGap2Crows
+	PatternXSideGap3Methods
+)
+
+// Direction is the market direction a candlestick pattern indicates.
+type Direction int
+
+const (
+	// Neutral patterns indicate indecision rather than a direction.
+	Neutral Direction = iota
+	// Bullish patterns indicate an upward move.
+	Bullish
+	// Bearish patterns indicate a downward move.
+	Bearish
+	// Bidirectional patterns have bullish and bearish forms, distinguished by the sign of the signal.
+	Bidirectional
+)
+
+func (d Direction) String() string {
+	switch d {
+	case Neutral:
+		return "neutral"
+	case Bullish:
+		return "bullish"
+	case Bearish:
+		return "bearish"
+	case Bidirectional:
+		return "bidirectional"
+	}
+	return "unknown"
+}
+
+// Reliability is a rough measure of how often a candlestick pattern is followed by the move it indicates.
+//
+// It is based on published statistics for the patterns, and is not provided by TA-Lib.
+type Reliability int
+
+const (
+	ReliabilityLow Reliability = iota + 1
+	ReliabilityMedium
+	ReliabilityHigh
+)
+
+func (r Reliability) String() string {
+	switch r {
+	case ReliabilityLow:
+		return "low"
+	case ReliabilityMedium:
+		return "medium"
+	case ReliabilityHigh:
+		return "high"
+	}
+	return "unknown"
+}
+
+// PatternInfo describes a candlestick pattern.
+type PatternInfo struct {
+	// Name is the TA-Lib name of the pattern, e.g. "CDLHAMMER".
+	Name        string
+	Description string
+	Direction   Direction
+	Reliability Reliability
+
+	// fn calls the Cdl function for the pattern, with the default penetration for those which take one.
+	fn func(open, high, low, close []float64, outInteger []int) ([]int, int)
+}
+
+var patterns = [...]PatternInfo{
+	Pattern2Crows:         {"CDL2CROWS", "Two Crows", Bearish, ReliabilityMedium, Cdl2Crows},
+	Pattern3BlackCrows:    {"CDL3BLACKCROWS", "Three Black Crows", Bearish, ReliabilityHigh, Cdl3BlackCrows},
+	Pattern3Inside:        {"CDL3INSIDE", "Three Inside Up/Down", Bidirectional, ReliabilityHigh, Cdl3Inside},
+	Pattern3LineStrike:    {"CDL3LINESTRIKE", "Three-Line Strike", Bidirectional, ReliabilityMedium, Cdl3LineStrike},
+	Pattern3Outside:       {"CDL3OUTSIDE", "Three Outside Up/Down", Bidirectional, ReliabilityHigh, Cdl3Outside},
+	Pattern3StarsInSouth:  {"CDL3STARSINSOUTH", "Three Stars In The South", Bullish, ReliabilityMedium, Cdl3StarsinSouth},
+	Pattern3WhiteSoldiers: {"CDL3WHITESOLDIERS", "Three Advancing White Soldiers", Bullish, ReliabilityHigh, Cdl3WhiteSoldiers},
+	PatternAbandonedBaby: {"CDLABANDONEDBABY", "Abandoned Baby", Bidirectional, ReliabilityHigh, func(open, high, low, close []float64, out []int) ([]int, int) {
+		return CdlAbandonedBaby(open, high, low, close, 0.3, out)
+	}},
+	PatternAdvanceBlock:       {"CDLADVANCEBLOCK", "Advance Block", Bearish, ReliabilityMedium, CdlAdvanceBlock},
+	PatternBeltHold:           {"CDLBELTHOLD", "Belt-hold", Bidirectional, ReliabilityLow, CdlBelthold},
+	PatternBreakaway:          {"CDLBREAKAWAY", "Breakaway", Bidirectional, ReliabilityMedium, CdlBreakaway},
+	PatternClosingMarubozu:    {"CDLCLOSINGMARUBOZU", "Closing Marubozu", Bidirectional, ReliabilityLow, CdlClosingMarubozu},
+	PatternConcealBabySwallow: {"CDLCONCEALBABYSWALL", "Concealing Baby Swallow", Bullish, ReliabilityHigh, CdlConcealBabySwall},
+	PatternCounterattack:      {"CDLCOUNTERATTACK", "Counterattack", Bidirectional, ReliabilityMedium, CdlCounterattack},
+	PatternDarkCloudCover: {"CDLDARKCLOUDCOVER", "Dark Cloud Cover", Bearish, ReliabilityHigh, func(open, high, low, close []float64, out []int) ([]int, int) {
+		return CdlDarkCloudCover(open, high, low, close, 0.5, out)
+	}},
+	PatternDoji:          {"CDLDOJI", "Doji", Neutral, ReliabilityLow, CdlDoji},
+	PatternDojiStar:      {"CDLDOJISTAR", "Doji Star", Bidirectional, ReliabilityMedium, CdlDojiStar},
+	PatternDragonflyDoji: {"CDLDRAGONFLYDOJI", "Dragonfly Doji", Neutral, ReliabilityLow, CdlDragonflyDoji},
+	PatternEngulfing:     {"CDLENGULFING", "Engulfing Pattern", Bidirectional, ReliabilityMedium, CdlEngulfing},
+	PatternEveningDojiStar: {"CDLEVENINGDOJISTAR", "Evening Doji Star", Bearish, ReliabilityHigh, func(open, high, low, close []float64, out []int) ([]int, int) {
+		return CdlEveningDojiStar(open, high, low, close, 0.3, out)
+	}},
+	PatternEveningStar: {"CDLEVENINGSTAR", "Evening Star", Bearish, ReliabilityHigh, func(open, high, low, close []float64, out []int) ([]int, int) {
+		return CdlEveningStar(open, high, low, close, 0.3, out)
+	}},
+	PatternGapSideSideWhite: {"CDLGAPSIDESIDEWHITE", "Up/Down-gap side-by-side white lines", Bidirectional, ReliabilityMedium, CdlGapSidesideWhite},
+	PatternGravestoneDoji:   {"CDLGRAVESTONEDOJI", "Gravestone Doji", Neutral, ReliabilityLow, CdlGravestoneDoji},
+	PatternHammer:           {"CDLHAMMER", "Hammer", Bullish, ReliabilityMedium, CdlHammer},
+	PatternHangingMan:       {"CDLHANGINGMAN", "Hanging Man", Bearish, ReliabilityMedium, CdlHangingMan},
+	PatternHarami:           {"CDLHARAMI", "Harami Pattern", Bidirectional, ReliabilityLow, CdlHarami},
+	PatternHaramiCross:      {"CDLHARAMICROSS", "Harami Cross Pattern", Bidirectional, ReliabilityMedium, CdlHaramiCross},
+	PatternHighWave:         {"CDLHIGHWAVE", "High-Wave Candle", Neutral, ReliabilityLow, CdlHighWave},
+	PatternHikkake:          {"CDLHIKKAKE", "Hikkake Pattern", Bidirectional, ReliabilityMedium, CdlHikkake},
+	PatternHikkakeMod:       {"CDLHIKKAKEMOD", "Modified Hikkake Pattern", Bidirectional, ReliabilityMedium, CdlHikkakeMod},
+	PatternHomingPigeon:     {"CDLHOMINGPIGEON", "Homing Pigeon", Bullish, ReliabilityMedium, CdlHomingPigeon},
+	PatternIdentical3Crows:  {"CDLIDENTICAL3CROWS", "Identical Three Crows", Bearish, ReliabilityHigh, CdlIdentical3Crows},
+	PatternInNeck:           {"CDLINNECK", "In-Neck Pattern", Bearish, ReliabilityMedium, CdlInNeck},
+	PatternInvertedHammer:   {"CDLINVERTEDHAMMER", "Inverted Hammer", Bullish, ReliabilityLow, CdlInvertedHammer},
+	PatternKicking:          {"CDLKICKING", "Kicking", Bidirectional, ReliabilityHigh, CdlKicking},
+	PatternKickingByLength:  {"CDLKICKINGBYLENGTH", "Kicking - bull/bear determined by the longer marubozu", Bidirectional, ReliabilityHigh, CdlKickingByLength},
+	PatternLadderBottom:     {"CDLLADDERBOTTOM", "Ladder Bottom", Bullish, ReliabilityMedium, CdlLadderBottom},
+	PatternLongLeggedDoji:   {"CDLLONGLEGGEDDOJI", "Long Legged Doji", Neutral, ReliabilityLow, CdlLongLeggedDoji},
+	PatternLongLine:         {"CDLLONGLINE", "Long Line Candle", Bidirectional, ReliabilityLow, CdlLongLine},
+	PatternMarubozu:         {"CDLMARUBOZU", "Marubozu", Bidirectional, ReliabilityLow, CdlMarubozu},
+	PatternMatchingLow:      {"CDLMATCHINGLOW", "Matching Low", Bullish, ReliabilityMedium, CdlMatchingLow},
+	PatternMatHold: {"CDLMATHOLD", "Mat Hold", Bullish, ReliabilityHigh, func(open, high, low, close []float64, out []int) ([]int, int) {
+		return CdlMatHold(open, high, low, close, 0.5, out)
+	}},
+	PatternMorningDojiStar: {"CDLMORNINGDOJISTAR", "Morning Doji Star", Bullish, ReliabilityHigh, func(open, high, low, close []float64, out []int) ([]int, int) {
+		return CdlMorningDojiStar(open, high, low, close, 0.3, out)
+	}},
+	PatternMorningStar: {"CDLMORNINGSTAR", "Morning Star", Bullish, ReliabilityHigh, func(open, high, low, close []float64, out []int) ([]int, int) {
+		return CdlMorningStar(open, high, low, close, 0.3, out)
+	}},
+	PatternOnNeck:           {"CDLONNECK", "On-Neck Pattern", Bearish, ReliabilityMedium, CdlOnNeck},
+	PatternPiercing:         {"CDLPIERCING", "Piercing Pattern", Bullish, ReliabilityMedium, CdlPiercing},
+	PatternRickshawMan:      {"CDLRICKSHAWMAN", "Rickshaw Man", Neutral, ReliabilityLow, CdlRickshawMan},
+	PatternRiseFall3Methods: {"CDLRISEFALL3METHODS", "Rising/Falling Three Methods", Bidirectional, ReliabilityHigh, CdlRiseFall3Methods},
+	PatternSeparatingLines:  {"CDLSEPARATINGLINES", "Separating Lines", Bidirectional, ReliabilityLow, CdlSeparatingLines},
+	PatternShootingStar:     {"CDLSHOOTINGSTAR", "Shooting Star", Bearish, ReliabilityMedium, CdlShootingStar},
+	PatternShortLine:        {"CDLSHORTLINE", "Short Line Candle", Bidirectional, ReliabilityLow, CdlShortLine},
+	PatternSpinningTop:      {"CDLSPINNINGTOP", "Spinning Top", Neutral, ReliabilityLow, CdlSpinningTop},
+	PatternStalledPattern:   {"CDLSTALLEDPATTERN", "Stalled Pattern", Bearish, ReliabilityMedium, CdlStalledPattern},
+	PatternStickSandwich:    {"CDLSTICKSANDWICH", "Stick Sandwich", Bullish, ReliabilityMedium, CdlStickSandwich},
+	PatternTakuri:           {"CDLTAKURI", "Takuri (Dragonfly Doji with very long lower shadow)", Bullish, ReliabilityMedium, CdlTakuri},
+	PatternTasukiGap:        {"CDLTASUKIGAP", "Tasuki Gap", Bidirectional, ReliabilityMedium, CdlTasukiGap},
+	PatternThrusting:        {"CDLTHRUSTING", "Thrusting Pattern", Bearish, ReliabilityLow, CdlThrusting},
+	PatternTristar:          {"CDLTRISTAR", "Tristar Pattern", Bidirectional, ReliabilityMedium, CdlTristar},
+	PatternUnique3River:     {"CDLUNIQUE3RIVER", "Unique 3 River", Bullish, ReliabilityMedium, CdlUnique3River},
+	PatternUpsideGap2Crows:  {"CDLUPSIDEGAP2CROWS", "Upside Gap Two Crows", Bearish, ReliabilityMedium, CdlUpsideGap2Crows},
+	PatternXSideGap3Methods: {"CDLXSIDEGAP3METHODS", "Upside/Downside Gap Three Methods", Bidirectional, ReliabilityMedium, CdlxSideGap3Methods},
+}
+
+// Patterns returns all of the candlestick patterns.
+func Patterns() []Pattern {
+	ps := make([]Pattern, len(patterns))
+	for i := range ps {
+		ps[i] = Pattern(i)
+	}
+	return ps
+}
+
+// Info returns the description of the pattern, or the zero PatternInfo if p is not a known pattern.
+func (p Pattern) Info() PatternInfo {
+	if p < 0 || int(p) >= len(patterns) {
+		return PatternInfo{}
+	}
+	return patterns[p]
+}
+
+func (p Pattern) String() string {
+	if p < 0 || int(p) >= len(patterns) {
+		return "unknown"
+	}
+	return patterns[p].Name
+}
+
+// CandleMatch is an occurrence of a candlestick pattern.
+type CandleMatch struct {
+	// Index is the position in the input of the last candle of the pattern.
+	Index   int
+	Pattern Pattern
+	// Signal is the value produced by the Cdl function. For patterns with a direction (see PatternInfo.Direction), it
+	// is positive for bullish patterns and negative for bearish. Neutral patterns (e.g. Doji and Spinning Top) do not
+	// indicate a direction, though their signal may still be positive, or signed by the color of the candle. Its
+	// magnitude is normally 100, but may be 200 for confirmations (e.g. CdlHikkake).
+	Signal int
+}
+
+// ScanCandles runs the recognizers for the given candlestick patterns, or all of them if none are given, and returns
+// the matches ordered by Index.
+//
+// Patterns which take a penetration parameter use the TA-Lib default. Unknown patterns are ignored.
+func ScanCandles(open, high, low, close []float64, patterns ...Pattern) []CandleMatch {
+	if len(patterns) == 0 {
+		patterns = Patterns()
+	}
+	var matches []CandleMatch
+	buf := make([]int, len(open))
+	for _, p := range patterns {
+		info := p.Info()
+		if info.fn == nil {
+			continue
+		}
+		out, begIdx := info.fn(open, high, low, close, buf)
+		for i, v := range out {
+			if v != 0 {
+				matches = append(matches, CandleMatch{Index: begIdx + i, Pattern: p, Signal: v})
+			}
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool { return matches[i].Index < matches[j].Index })
+	return matches
+}
+
+// ScanCandles calls ScanCandles with the Open, High, Low and Close columns of s.
+func (s OHLCV) ScanCandles(patterns ...Pattern) []CandleMatch {
+	s.mustValidate("Open", "High", "Low", "Close")
+	return ScanCandles(s.Open, s.High, s.Low, s.Close, patterns...)
+}
@@ -0,0 +1,49 @@
+package talib_test
+
+import (
+	"testing"
+
+	"github.com/phemmer/talib"
+)
+
+func TestPatterns(t *testing.T) {
+	names := map[string]bool{}
+	for _, p := range talib.Patterns() {
+		info := p.Info()
+		if info.Name == "" || info.Description == "" || info.Reliability == 0 || names[info.Name] {
+			t.Errorf("Invalid pattern info %#v.", info)
+		}
+		names[info.Name] = true
+	}
+	if len(names) != 61 {
+		t.Errorf("Expected 61 patterns got %d.", len(names))
+	}
+
+	for _, p := range []talib.Pattern{-1, 61} {
+		if info := p.Info(); info.Name != "" || p.String() != "unknown" {
+			t.Errorf("Expected no info for pattern %d got %#v.", int(p), info)
+		}
+	}
+}
+
+func TestScanCandles(t *testing.T) {
+	open := []float64{10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 9.5, 8.5}
+	high := []float64{10.5, 10.5, 10.5, 10.5, 10.5, 10.5, 10.5, 10.5, 10.5, 10.5, 10.5, 10.5, 9.6, 10.2}
+	low := []float64{9.5, 9.5, 9.5, 9.5, 9.5, 9.5, 9.5, 9.5, 9.5, 9.5, 9.5, 9.5, 8.8, 8.4}
+	close := []float64{10.2, 9.8, 10.2, 9.8, 10.2, 9.8, 10.2, 9.8, 10.2, 9.8, 10.2, 9.8, 9, 10}
+	matches := talib.ScanCandles(open, high, low, close, talib.PatternEngulfing)
+	expected, begIdx := talib.CdlEngulfing(open, high, low, close, nil)
+	n := 0
+	for i, v := range expected {
+		if v == 0 {
+			continue
+		}
+		if n >= len(matches) || matches[n].Index != begIdx+i || matches[n].Signal != v || matches[n].Pattern != talib.PatternEngulfing {
+			t.Fatalf("Expected match at %d with signal %d got %#v.", begIdx+i, v, matches)
+		}
+		n++
+	}
+	if n != len(matches) || n == 0 {
+		t.Errorf("Expected %d matches got %#v.", n, matches)
+	}
+}
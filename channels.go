@@ -0,0 +1,111 @@
+package talib
+
+// Keltner returns the Keltner Channels: a moving average of close, with bands at multiplier times the average true range
+// over atrPeriod above and below it. mAType may be any type accepted by MovingAverage.
+func Keltner(high, low, close []float64, timePeriod, atrPeriod int, multiplier float64, mAType MAType, outRealUpperBand []float64, outRealMiddleBand []float64, outRealLowerBand []float64) ([]float64, []float64, []float64, int) {
+	if outRealUpperBand == nil {
+		outRealUpperBand = make([]float64, len(close))
+	}
+	if outRealMiddleBand == nil {
+		outRealMiddleBand = make([]float64, len(close))
+	}
+	if outRealLowerBand == nil {
+		outRealLowerBand = make([]float64, len(close))
+	}
+	ma, maBegIdx := MovingAverage(close, timePeriod, mAType, nil)
+	atr, atrBegIdx := Atr(high, low, close, atrPeriod, nil)
+	if len(ma) == 0 || len(atr) == 0 {
+		return outRealUpperBand[:0], outRealMiddleBand[:0], outRealLowerBand[:0], 0
+	}
+
+	begIdx := maBegIdx
+	if atrBegIdx > begIdx {
+		begIdx = atrBegIdx
+	}
+	for i := begIdx; i < len(close); i++ {
+		m, a := ma[i-maBegIdx], atr[i-atrBegIdx]
+		outRealUpperBand[i-begIdx] = m + multiplier*a
+		outRealMiddleBand[i-begIdx] = m
+		outRealLowerBand[i-begIdx] = m - multiplier*a
+	}
+	n := len(close) - begIdx
+	return outRealUpperBand[:n], outRealMiddleBand[:n], outRealLowerBand[:n], begIdx
+}
+
+// Donchian returns the Donchian Channels: the highest high and lowest low over timePeriod, and the midpoint between them.
+func Donchian(high, low []float64, timePeriod int, outRealUpperBand []float64, outRealMiddleBand []float64, outRealLowerBand []float64) ([]float64, []float64, []float64, int) {
+	upper, begIdx := Max(high, timePeriod, outRealUpperBand)
+	lower, _ := Min(low, timePeriod, outRealLowerBand)
+	if outRealMiddleBand == nil {
+		outRealMiddleBand = make([]float64, len(high))
+	}
+	for i := range upper {
+		outRealMiddleBand[i] = (upper[i] + lower[i]) / 2
+	}
+	return upper, outRealMiddleBand[:len(upper)], lower, begIdx
+}
+
+// SuperTrend returns the SuperTrend indicator, and the direction of the trend.
+//
+// Bands are placed at multiplier times the average true range over timePeriod above and below the midpoint of the high
+// and low. The lower band only rises and the upper band only falls while the trend continues. The trend turns down when
+// the close falls below the lower band, and up when it rises above the upper band. The SuperTrend is the lower band in
+// an up trend, and the upper band in a down trend. The direction is 1 in an up trend and -1 in a down trend, starting
+// with an up trend.
+func SuperTrend(high, low, close []float64, timePeriod int, multiplier float64, outReal []float64, outInteger []int) ([]float64, []int, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(close))
+	}
+	if outInteger == nil {
+		outInteger = make([]int, len(close))
+	}
+	atr, begIdx := Atr(high, low, close, timePeriod, nil)
+	if len(atr) == 0 {
+		return outReal[:0], outInteger[:0], 0
+	}
+
+	var upper, lower float64
+	trend := 1
+	for k, a := range atr {
+		i := k + begIdx
+		mid := (high[i] + low[i]) / 2
+		basicUpper, basicLower := mid+multiplier*a, mid-multiplier*a
+		if k == 0 || basicUpper < upper || close[i-1] > upper {
+			upper = basicUpper
+		}
+		if k == 0 || basicLower > lower || close[i-1] < lower {
+			lower = basicLower
+		}
+
+		if trend == 1 && close[i] < lower {
+			trend = -1
+		} else if trend == -1 && close[i] > upper {
+			trend = 1
+		}
+		if trend == 1 {
+			outReal[k] = lower
+		} else {
+			outReal[k] = upper
+		}
+		outInteger[k] = trend
+	}
+	return outReal[:len(atr)], outInteger[:len(atr)], begIdx
+}
+
+// Keltner calls Keltner with the High, Low and Close columns of s.
+func (s OHLCV) Keltner(timePeriod, atrPeriod int, multiplier float64, mAType MAType) ([]float64, []float64, []float64, int) {
+	s.mustValidate("High", "Low", "Close")
+	return Keltner(s.High, s.Low, s.Close, timePeriod, atrPeriod, multiplier, mAType, nil, nil, nil)
+}
+
+// Donchian calls Donchian with the High and Low columns of s.
+func (s OHLCV) Donchian(timePeriod int) ([]float64, []float64, []float64, int) {
+	s.mustValidate("High", "Low")
+	return Donchian(s.High, s.Low, timePeriod, nil, nil, nil)
+}
+
+// SuperTrend calls SuperTrend with the High, Low and Close columns of s.
+func (s OHLCV) SuperTrend(timePeriod int, multiplier float64) ([]float64, []int, int) {
+	s.mustValidate("High", "Low", "Close")
+	return SuperTrend(s.High, s.Low, s.Close, timePeriod, multiplier, nil, nil)
+}
@@ -0,0 +1,68 @@
+package talib_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/phemmer/talib"
+)
+
+func TestKeltner(t *testing.T) {
+	high := []float64{11, 12, 13, 12, 14, 15, 14, 16}
+	low := []float64{9, 10, 11, 10, 12, 13, 12, 14}
+	close := []float64{10, 11, 12, 11, 13, 14, 13, 15}
+
+	upper, middle, lower, begIdx := talib.Keltner(high, low, close, 3, 4, 2, talib.MAType_EMA, nil, nil, nil)
+	ema, emaBegIdx := talib.Ema(close, 3, nil)
+	atr, atrBegIdx := talib.Atr(high, low, close, 4, nil)
+	if begIdx != atrBegIdx || len(middle) != len(atr) {
+		t.Fatalf("Expected begIdx %d got %d.", atrBegIdx, begIdx)
+	}
+	for i := range middle {
+		if middle[i] != ema[i+begIdx-emaBegIdx] || math.Abs(upper[i]-lower[i]-4*atr[i]) > 1e-9 {
+			t.Errorf("Unexpected bands at %d: %v %v %v.", i, upper[i], middle[i], lower[i])
+		}
+	}
+}
+
+func TestDonchian(t *testing.T) {
+	high := []float64{3, 5, 4, 6, 2}
+	low := []float64{1, 2, 0, 3, 1}
+	upper, middle, lower, begIdx := talib.Donchian(high, low, 3, nil, nil, nil)
+	if begIdx != 2 {
+		t.Errorf("Expected begIdx 2 got %d.", begIdx)
+	}
+	for _, c := range []struct{ expected, got []float64 }{
+		{[]float64{5, 6, 6}, upper},
+		{[]float64{2.5, 3, 3}, middle},
+		{[]float64{0, 0, 0}, lower},
+	} {
+		if !reflect.DeepEqual(c.expected, c.got) {
+			t.Errorf("Expected %#v got %#v.", c.expected, c.got)
+		}
+	}
+}
+
+func TestSuperTrend(t *testing.T) {
+	close := []float64{10, 11, 12, 13, 14, 15, 16, 17, 12, 10, 9, 8, 7}
+	high := make([]float64, len(close))
+	low := make([]float64, len(close))
+	for i, c := range close {
+		high[i], low[i] = c+0.5, c-0.5
+	}
+
+	st, dir, begIdx := talib.SuperTrend(high, low, close, 3, 1, nil, nil)
+	if begIdx != 3 || len(st) != len(close)-3 {
+		t.Fatalf("Unexpected begIdx %d and length %d.", begIdx, len(st))
+	}
+	expected := []int{1, 1, 1, 1, 1, -1, -1, -1, -1, -1}
+	if !reflect.DeepEqual(expected, dir) {
+		t.Errorf("Expected %#v got %#v.", expected, dir)
+	}
+	for i := range st {
+		if dir[i] == 1 && st[i] >= close[i+begIdx] || dir[i] == -1 && st[i] <= close[i+begIdx] {
+			t.Errorf("SuperTrend %v on the wrong side of close %v at %d.", st[i], close[i+begIdx], i)
+		}
+	}
+}
@@ -0,0 +1,241 @@
+/*
+Command talib runs the functions of the talib package over OHLCV data in CSV format.
+
+Usage:
+
+	talib list
+	talib describe FUNCTION
+	talib run FUNCTION [flags] [FILE]
+
+The run command reads bars from FILE, or stdin if not given, and writes them to stdout with a column appended for each
+output of the function. Options of the function are given as flags, e.g.:
+
+	talib run BBANDS --timeperiod 20 --nbdevup 2 prices.csv
+
+Options which are not given use the TA-Lib defaults. See `talib describe FUNCTION` for the flags accepted by a function.
+*/
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/phemmer/talib"
+)
+
+func main() {
+	if err := run(os.Args[1:], os.Stdin, os.Stdout); err != nil {
+		fmt.Fprintf(os.Stderr, "talib: %s\n", strings.TrimPrefix(err.Error(), "talib: "))
+		os.Exit(1)
+	}
+}
+
+const usage = `usage:
+	talib list
+	talib describe FUNCTION
+	talib run FUNCTION [flags] [FILE]`
+
+func run(args []string, stdin io.Reader, stdout io.Writer) error {
+	if len(args) == 0 {
+		return fmt.Errorf("%s", usage)
+	}
+	switch args[0] {
+	case "list":
+		return list(stdout)
+	case "describe":
+		if len(args) != 2 {
+			return fmt.Errorf("%s", usage)
+		}
+		f, err := lookup(args[1])
+		if err != nil {
+			return err
+		}
+		return describe(stdout, f)
+	case "run":
+		if len(args) < 2 {
+			return fmt.Errorf("%s", usage)
+		}
+		f, err := lookup(args[1])
+		if err != nil {
+			return err
+		}
+		return runFunc(f, args[2:], stdin, stdout)
+	case "help", "-h", "-help", "--help":
+		fmt.Fprintln(stdout, usage)
+		return nil
+	}
+	return fmt.Errorf("unknown command %q\n%s", args[0], usage)
+}
+
+func lookup(name string) (*talib.FuncInfo, error) {
+	f := talib.LookupFunction(name)
+	if f == nil {
+		return nil, fmt.Errorf("unknown function %q", name)
+	}
+	return f, nil
+}
+
+func list(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	for _, f := range talib.Functions() {
+		fmt.Fprintf(tw, "%s\t%s\t%s\n", f.Name, f.Group, f.Description)
+	}
+	return tw.Flush()
+}
+
+// flagName returns the command line flag for a parameter name.
+func flagName(name string) string {
+	return strings.ToLower(name)
+}
+
+// isPriceInput returns whether the input is one of the columns of the bars.
+func isPriceInput(name string) bool {
+	switch name {
+	case "open", "high", "low", "close", "volume":
+		return true
+	}
+	return false
+}
+
+func formatRange(o talib.OptionInfo) string {
+	format := func(v float64) string {
+		switch {
+		case v >= 1e300:
+			return "max"
+		case v <= -1e300:
+			return "min"
+		}
+		return fmt.Sprint(v)
+	}
+	return format(o.Min) + " to " + format(o.Max)
+}
+
+func describe(w io.Writer, f *talib.FuncInfo) error {
+	fmt.Fprintf(w, "%s - %s\n", f.Name, f.Description)
+	if f.Group != "" {
+		fmt.Fprintf(w, "Group: %s\n", f.Group)
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "\nInputs:")
+	for _, in := range f.Inputs {
+		if isPriceInput(in) {
+			fmt.Fprintf(tw, "  %s\n", in)
+		} else {
+			fmt.Fprintf(tw, "  %s\t--%s COLUMN\t(default close)\n", in, flagName(in))
+		}
+	}
+	if len(f.Options) > 0 {
+		fmt.Fprintln(tw, "\nOptions:")
+		for _, o := range f.Options {
+			fmt.Fprintf(tw, "  --%s\t%s\t%s\tdefault %v\t%s\n", flagName(o.Name), o.Type, formatRange(o), o.Default, o.Description)
+		}
+	}
+	fmt.Fprintln(tw, "\nOutputs:")
+	for _, o := range f.Outputs {
+		fmt.Fprintf(tw, "  %s\n", columnName(f, o))
+	}
+	return tw.Flush()
+}
+
+// columnName returns the name of the column an output is written to.
+func columnName(f *talib.FuncInfo, o talib.OutputInfo) string {
+	name := strings.ToLower(f.Name)
+	if len(f.Outputs) > 1 {
+		name += "_" + strings.ToLower(o.Name)
+	}
+	return name
+}
+
+func column(bars talib.OHLCV, name string) ([]float64, error) {
+	switch name {
+	case "open":
+		return bars.Open, nil
+	case "high":
+		return bars.High, nil
+	case "low":
+		return bars.Low, nil
+	case "close":
+		return bars.Close, nil
+	case "volume":
+		return bars.Volume, nil
+	}
+	return nil, fmt.Errorf("unknown column %q", name)
+}
+
+func runFunc(f *talib.FuncInfo, args []string, stdin io.Reader, stdout io.Writer) error {
+	fs := flag.NewFlagSet(f.Name, flag.ContinueOnError)
+	format := fs.String("format", "csv", "output format, csv or json")
+	timeFormat := fs.String("timeformat", "", "layout of the input times, or unix or unixms")
+
+	options := f.Defaults()
+	for i, o := range f.Options {
+		fs.Float64Var(&options[i], flagName(o.Name), o.Default, o.Description)
+	}
+	inputColumns := make([]string, len(f.Inputs))
+	for i, in := range f.Inputs {
+		inputColumns[i] = in
+		if !isPriceInput(in) {
+			fs.StringVar(&inputColumns[i], flagName(in), "close", "column to use for the "+in+" input")
+		}
+	}
+
+	// Allow the file to be given before the flags, as well as after.
+	var file string
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		file, args = args[0], args[1:]
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	switch {
+	case fs.NArg() > 1 || (fs.NArg() == 1 && file != ""):
+		return fmt.Errorf("too many arguments")
+	case fs.NArg() == 1:
+		file = fs.Arg(0)
+	}
+	if *format != "csv" && *format != "json" {
+		return fmt.Errorf("unknown format %q", *format)
+	}
+
+	r := stdin
+	if file != "" && file != "-" {
+		fh, err := os.Open(file)
+		if err != nil {
+			return err
+		}
+		defer fh.Close()
+		r = fh
+	}
+	bars, err := talib.ReadCSV(r, talib.ReadOptions{TimeFormat: *timeFormat})
+	if err != nil {
+		return err
+	}
+
+	inputs := make([][]float64, len(f.Inputs))
+	for i, name := range inputColumns {
+		if inputs[i], err = column(bars, name); err != nil {
+			return err
+		}
+		if len(inputs[i]) == 0 {
+			return fmt.Errorf("input has no %s column", name)
+		}
+	}
+	outputs, begIdx, err := f.Call(inputs, options)
+	if err != nil {
+		return err
+	}
+
+	columns := make([]talib.Column, len(outputs))
+	for i, out := range outputs {
+		columns[i] = talib.Column{Name: columnName(f, f.Outputs[i]), Values: out, BegIdx: begIdx}
+	}
+	if *format == "json" {
+		return talib.WriteJSONLines(stdout, bars, columns...)
+	}
+	return talib.WriteCSV(stdout, bars, columns...)
+}
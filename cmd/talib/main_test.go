@@ -0,0 +1,52 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestList(t *testing.T) {
+	var out bytes.Buffer
+	if err := run([]string{"list"}, nil, &out); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out.String(), "SMA ") || !strings.Contains(out.String(), "BBANDS ") {
+		t.Errorf("Expected SMA and BBANDS to be listed, got %q.", out.String())
+	}
+}
+
+func TestDescribe(t *testing.T) {
+	var out bytes.Buffer
+	if err := run([]string{"describe", "mama"}, nil, &out); err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []string{"MAMA - ", "--fastlimit", "--slowlimit", "mama_mama", "mama_fama"} {
+		if !strings.Contains(out.String(), s) {
+			t.Errorf("Expected %q in %q.", s, out.String())
+		}
+	}
+
+	if err := run([]string{"describe", "nosuchfunction"}, nil, &out); err == nil {
+		t.Errorf("Expected error for unknown function.")
+	}
+}
+
+func TestRun(t *testing.T) {
+	in := "time,close\n2019-01-02,1\n2019-01-03,2\n2019-01-04,3\n"
+	var out bytes.Buffer
+	if err := run([]string{"run", "SMA", "--timeperiod", "2"}, strings.NewReader(in), &out); err != nil {
+		t.Fatal(err)
+	}
+	expected := "time,close,sma\n" +
+		"2019-01-02T00:00:00Z,1,\n" +
+		"2019-01-03T00:00:00Z,2,1.5\n" +
+		"2019-01-04T00:00:00Z,3,2.5\n"
+	if out.String() != expected {
+		t.Errorf("Expected %q got %q.", expected, out.String())
+	}
+
+	if err := run([]string{"run", "ATR"}, strings.NewReader(in), &out); err == nil {
+		t.Errorf("Expected error for missing high and low columns.")
+	}
+}
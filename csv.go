@@ -0,0 +1,354 @@
+package talib
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Columns maps the columns of an OHLCV to the fields of a file.
+//
+// Each entry is the name of the field (matched case-insensitively), or for CSV files without a header, the 0 based index
+// of the field (e.g. "2"). An empty entry uses the default names for the column, or the default position if the file
+// has no header. A "-" entry means the column is not present.
+type Columns struct {
+	Time, Open, High, Low, Close, Volume string
+}
+
+// defaultColumnNames are the names recognized for each column, in the order of the default positions.
+var defaultColumnNames = [][]string{
+	{"time", "date", "datetime", "timestamp", "t"},
+	{"open", "o"},
+	{"high", "h"},
+	{"low", "l"},
+	{"close", "c", "price", "last"},
+	{"volume", "v", "vol"},
+}
+
+func (c Columns) list() []string {
+	return []string{c.Time, c.Open, c.High, c.Low, c.Close, c.Volume}
+}
+
+// ReadOptions controls how ReadCSV and ReadJSONLines parse their input.
+type ReadOptions struct {
+	Columns Columns
+	// TimeFormat is the layout used to parse times, as used by time.Parse. It may also be "unix" or "unixms" for
+	// seconds or milliseconds since the epoch. If empty, RFC 3339, several common date formats, and numeric unix times
+	// are recognized.
+	TimeFormat string
+	// Location is the time zone used for times which do not specify one. If nil, UTC is used.
+	Location *time.Location
+	// Comma is the CSV field delimiter. If 0, ',' is used.
+	Comma rune
+	// Header controls whether the first CSV record is a header. If HeaderAuto, the first record is treated as a header
+	// if any of the columns are given by name, or if any of its non-empty fields at the positions of the price columns
+	// is not a number.
+	Header Header
+}
+
+// Header specifies whether a CSV file has a header.
+type Header int
+
+const (
+	// HeaderAuto detects whether the first record is a header.
+	HeaderAuto Header = iota
+	// HeaderPresent means the first record is a header.
+	HeaderPresent
+	// HeaderAbsent means there is no header.
+	HeaderAbsent
+)
+
+var timeFormats = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04",
+	"2006-01-02",
+	"20060102",
+	"01/02/2006 15:04:05",
+	"01/02/2006",
+}
+
+func (o ReadOptions) location() *time.Location {
+	if o.Location == nil {
+		return time.UTC
+	}
+	return o.Location
+}
+
+func (o ReadOptions) parseTime(s string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	switch o.TimeFormat {
+	case "":
+	case "unix", "unixms":
+		n, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("talib: invalid unix time %q", s)
+		}
+		if o.TimeFormat == "unixms" {
+			n /= 1000
+		}
+		return unixTime(n), nil
+	default:
+		return time.ParseInLocation(o.TimeFormat, s, o.location())
+	}
+
+	for _, f := range timeFormats {
+		if t, err := time.ParseInLocation(f, s, o.location()); err == nil {
+			return t, nil
+		}
+	}
+	if n, err := strconv.ParseFloat(s, 64); err == nil {
+		// Anything past 1e11 seconds (year 5138) is assumed to be milliseconds.
+		if n > 1e11 {
+			n /= 1000
+		}
+		return unixTime(n), nil
+	}
+	return time.Time{}, fmt.Errorf("talib: unrecognized time %q", s)
+}
+
+func unixTime(sec float64) time.Time {
+	s, frac := math.Modf(sec)
+	return time.Unix(int64(s), int64(math.Round(frac*1e9))).UTC()
+}
+
+// fields returns the position of each OHLCV column within header, or -1 if not present. The Close column must be
+// present, unless it is "-".
+func (c Columns) fields(header []string) ([]int, error) {
+	idx := make([]int, 6)
+	for i, name := range c.list() {
+		idx[i] = -1
+		if name == "-" {
+			continue
+		}
+		names := defaultColumnNames[i]
+		if name != "" {
+			names = []string{name}
+		}
+	search:
+		for _, n := range names {
+			for j, h := range header {
+				if strings.EqualFold(strings.TrimSpace(h), n) {
+					idx[i] = j
+					break search
+				}
+			}
+		}
+		if name != "" && idx[i] < 0 {
+			return nil, fmt.Errorf("talib: column %q not found", name)
+		}
+	}
+	if c.Close != "-" && idx[4] < 0 {
+		return nil, fmt.Errorf("talib: no close column found in header %q", strings.Join(header, ","))
+	}
+	for _, p := range idx {
+		if p >= 0 {
+			return idx, nil
+		}
+	}
+	return nil, fmt.Errorf("talib: no columns found in header %q", strings.Join(header, ","))
+}
+
+// positions returns the position of each OHLCV column for a file without a header of width n.
+func (c Columns) positions(n int) ([]int, error) {
+	idx := make([]int, 6)
+	for i, name := range c.list() {
+		switch name {
+		case "-":
+			idx[i] = -1
+		case "":
+			idx[i] = i
+		default:
+			p, err := strconv.Atoi(name)
+			if err != nil {
+				return nil, fmt.Errorf("talib: column %q must be a field index when there is no header", name)
+			}
+			idx[i] = p
+		}
+		if idx[i] >= n {
+			if name != "" {
+				return nil, fmt.Errorf("talib: column %q is out of range", name)
+			}
+			idx[i] = -1
+		}
+	}
+	return idx, nil
+}
+
+// isHeader returns whether record is a header: either the columns are given by name, or any of the non-empty fields at
+// the positions of the price columns is not a number. Other fields, such as the time, are not checked, and empty fields
+// are ignored, as they are read as NaN.
+func (c Columns) isHeader(record []string) bool {
+	idx, err := c.positions(len(record))
+	if err != nil {
+		return true
+	}
+	for _, p := range idx[1:] {
+		if p < 0 {
+			continue
+		}
+		f := strings.TrimSpace(record[p])
+		if f == "" {
+			continue
+		}
+		if _, err := strconv.ParseFloat(f, 64); err != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// appendBar parses the fields of a record into s, using the field positions in idx.
+func (s *OHLCV) appendBar(fields []string, idx []int, opts ReadOptions) error {
+	if idx[0] >= 0 {
+		t, err := opts.parseTime(fields[idx[0]])
+		if err != nil {
+			return err
+		}
+		s.Time = append(s.Time, t)
+	}
+	cols := []*[]float64{&s.Open, &s.High, &s.Low, &s.Close, &s.Volume}
+	for i, col := range cols {
+		p := idx[i+1]
+		if p < 0 {
+			continue
+		}
+		f := strings.TrimSpace(fields[p])
+		v := math.NaN()
+		if f != "" {
+			var err error
+			if v, err = strconv.ParseFloat(f, 64); err != nil {
+				return fmt.Errorf("talib: invalid number %q", f)
+			}
+		}
+		*col = append(*col, v)
+	}
+	return nil
+}
+
+// ReadCSV reads bars from CSV data.
+//
+// Empty price fields are read as NaN.
+func ReadCSV(r io.Reader, opts ReadOptions) (OHLCV, error) {
+	cr := csv.NewReader(r)
+	if opts.Comma != 0 {
+		cr.Comma = opts.Comma
+	}
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+
+	var s OHLCV
+	var idx []int
+	for line := 1; ; line++ {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return OHLCV{}, err
+		}
+
+		if idx == nil {
+			header := opts.Header == HeaderPresent || (opts.Header == HeaderAuto && opts.Columns.isHeader(record))
+			if header {
+				if idx, err = opts.Columns.fields(record); err != nil {
+					return OHLCV{}, err
+				}
+				continue
+			}
+			if idx, err = opts.Columns.positions(len(record)); err != nil {
+				return OHLCV{}, err
+			}
+		}
+
+		for _, p := range idx {
+			if p >= len(record) {
+				return OHLCV{}, fmt.Errorf("talib: line %d: too few fields", line)
+			}
+		}
+		if err := s.appendBar(record, idx, opts); err != nil {
+			return OHLCV{}, fmt.Errorf("talib: line %d: %s", line, strings.TrimPrefix(err.Error(), "talib: "))
+		}
+	}
+	return s, nil
+}
+
+// Column is a named column of output, such as the result of a function, to be written alongside bars.
+type Column struct {
+	Name   string
+	Values []float64
+	// BegIdx is the index of the bar corresponding to Values[0].
+	BegIdx int
+}
+
+// value returns the value of the column for bar i, or NaN if the column has no value for it.
+func (c Column) value(i int) float64 {
+	i -= c.BegIdx
+	if i < 0 || i >= len(c.Values) {
+		return math.NaN()
+	}
+	return c.Values[i]
+}
+
+// barFields returns the names and values of the bar columns which are set, followed by the given columns.
+func barFields(bars OHLCV, columns []Column) []Column {
+	var fields []Column
+	for i, v := range [][]float64{bars.Open, bars.High, bars.Low, bars.Close, bars.Volume} {
+		if len(v) > 0 {
+			fields = append(fields, Column{Name: defaultColumnNames[i+1][0], Values: v})
+		}
+	}
+	return append(fields, columns...)
+}
+
+func formatFloat(v float64) string {
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+// WriteCSV writes bars as CSV with a header, followed by the given columns.
+//
+// Only the columns of bars which are set are written. Times are written in RFC 3339 format. Values which are NaN, or
+// outside of the range of a column, are written as empty fields.
+func WriteCSV(w io.Writer, bars OHLCV, columns ...Column) error {
+	if err := bars.Validate(); err != nil {
+		return err
+	}
+	fields := barFields(bars, columns)
+
+	cw := csv.NewWriter(w)
+	var record []string
+	if len(bars.Time) > 0 {
+		record = append(record, defaultColumnNames[0][0])
+	}
+	for _, f := range fields {
+		record = append(record, f.Name)
+	}
+	if err := cw.Write(record); err != nil {
+		return err
+	}
+
+	for i := 0; i < bars.Len(); i++ {
+		record = record[:0]
+		if len(bars.Time) > 0 {
+			record = append(record, bars.Time[i].Format(time.RFC3339Nano))
+		}
+		for _, f := range fields {
+			v := f.value(i)
+			if math.IsNaN(v) {
+				record = append(record, "")
+			} else {
+				record = append(record, formatFloat(v))
+			}
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
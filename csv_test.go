@@ -0,0 +1,148 @@
+package talib_test
+
+import (
+	"bytes"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/phemmer/talib"
+)
+
+func TestReadCSV(t *testing.T) {
+	data := "Date,Open,High,Low,Close,Adj Close,Volume\n" +
+		"2019-01-02,10,12,9,11,11,1000\n" +
+		"2019-01-03,11,13,10,12,12,1500\n"
+	s, err := talib.ReadCSV(strings.NewReader(data), talib.ReadOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := talib.OHLCV{
+		Time:   []time.Time{time.Date(2019, 1, 2, 0, 0, 0, 0, time.UTC), time.Date(2019, 1, 3, 0, 0, 0, 0, time.UTC)},
+		Open:   []float64{10, 11},
+		High:   []float64{12, 13},
+		Low:    []float64{9, 10},
+		Close:  []float64{11, 12},
+		Volume: []float64{1000, 1500},
+	}
+	if !reflect.DeepEqual(expected, s) {
+		t.Errorf("Expected %#v got %#v.", expected, s)
+	}
+}
+
+func TestReadCSVNoHeader(t *testing.T) {
+	data := "1546387200;11;9\n1546473600;12;10\n"
+	opts := talib.ReadOptions{
+		Comma:   ';',
+		Columns: talib.Columns{Open: "-", High: "1", Low: "2", Close: "-", Volume: "-"},
+	}
+	s, err := talib.ReadCSV(strings.NewReader(data), opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := talib.OHLCV{
+		Time: []time.Time{time.Date(2019, 1, 2, 0, 0, 0, 0, time.UTC), time.Date(2019, 1, 3, 0, 0, 0, 0, time.UTC)},
+		High: []float64{11, 12},
+		Low:  []float64{9, 10},
+	}
+	if !reflect.DeepEqual(expected, s) {
+		t.Errorf("Expected %#v got %#v.", expected, s)
+	}
+}
+
+func TestReadCSVNoHeaderBlankField(t *testing.T) {
+	// The first record has no high, but is still data.
+	data := "2019-01-02,10,,9,11,1000\n2019-01-03,11,13,10,12,1500\n"
+	s, err := talib.ReadCSV(strings.NewReader(data), talib.ReadOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Len() != 2 || !math.IsNaN(s.High[0]) || s.High[1] != 13 || !reflect.DeepEqual([]float64{10, 11}, s.Open) {
+		t.Errorf("Expected 2 bars with a NaN high in the first, got %#v.", s)
+	}
+}
+
+func TestReadCSVNoHeaderTimeColumns(t *testing.T) {
+	tests := []struct {
+		data    string
+		columns talib.Columns
+	}{
+		// Separate date and time columns, of which only the date is read.
+		{
+			"2019-01-02,09:30,10,12,9,11,1000\n2019-01-03,09:30,11,13,10,12,1500\n",
+			talib.Columns{Time: "0", Open: "2", High: "3", Low: "4", Close: "5", Volume: "6"},
+		},
+		// The time in the last column.
+		{
+			"10,12,9,11,1000,2019-01-02\n11,13,10,12,1500,2019-01-03\n",
+			talib.Columns{Time: "5", Open: "0", High: "1", Low: "2", Close: "3", Volume: "4"},
+		},
+	}
+	expected := talib.OHLCV{
+		Time:   []time.Time{time.Date(2019, 1, 2, 0, 0, 0, 0, time.UTC), time.Date(2019, 1, 3, 0, 0, 0, 0, time.UTC)},
+		Open:   []float64{10, 11},
+		High:   []float64{12, 13},
+		Low:    []float64{9, 10},
+		Close:  []float64{11, 12},
+		Volume: []float64{1000, 1500},
+	}
+	for _, test := range tests {
+		s, err := talib.ReadCSV(strings.NewReader(test.data), talib.ReadOptions{Columns: test.columns})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(expected, s) {
+			t.Errorf("Expected %#v got %#v.", expected, s)
+		}
+	}
+}
+
+func TestReadCSVHeaderNoColumns(t *testing.T) {
+	for _, data := range []string{"Foo,Bar\n1,2\n", "Date,Open\n2019-01-02,10\n"} {
+		if _, err := talib.ReadCSV(strings.NewReader(data), talib.ReadOptions{}); err == nil {
+			t.Errorf("Expected error for header with no close column: %q.", data)
+		}
+	}
+}
+
+func TestWriteCSV(t *testing.T) {
+	bars := talib.OHLCV{
+		Time:  []time.Time{time.Date(2019, 1, 2, 0, 0, 0, 0, time.UTC), time.Date(2019, 1, 3, 0, 0, 0, 0, time.UTC)},
+		Close: []float64{11, 12.5},
+	}
+	var buf bytes.Buffer
+	if err := talib.WriteCSV(&buf, bars, talib.Column{Name: "sma", Values: []float64{11.75}, BegIdx: 1}); err != nil {
+		t.Fatal(err)
+	}
+	expected := "time,close,sma\n2019-01-02T00:00:00Z,11,\n2019-01-03T00:00:00Z,12.5,11.75\n"
+	if buf.String() != expected {
+		t.Errorf("Expected %q got %q.", expected, buf.String())
+	}
+}
+
+func TestJSONLines(t *testing.T) {
+	bars := talib.OHLCV{
+		Time:   []time.Time{time.Date(2019, 1, 2, 0, 0, 0, 0, time.UTC), time.Date(2019, 1, 3, 0, 0, 0, 0, time.UTC)},
+		Close:  []float64{11, math.NaN()},
+		Volume: []float64{100, 200},
+	}
+	var buf bytes.Buffer
+	if err := talib.WriteJSONLines(&buf, bars); err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"time":"2019-01-02T00:00:00Z","close":11,"volume":100}` + "\n" +
+		`{"time":"2019-01-03T00:00:00Z","close":null,"volume":200}` + "\n"
+	if buf.String() != expected {
+		t.Errorf("Expected %q got %q.", expected, buf.String())
+	}
+
+	s, err := talib.ReadJSONLines(&buf, talib.ReadOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(bars.Time, s.Time) || s.Close[0] != 11 || !math.IsNaN(s.Close[1]) || !reflect.DeepEqual(bars.Volume, s.Volume) {
+		t.Errorf("Expected %#v got %#v.", bars, s)
+	}
+}
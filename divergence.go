@@ -0,0 +1,156 @@
+package talib
+
+import "sort"
+
+// DivergenceKind is the type of a divergence between price and an indicator.
+type DivergenceKind int
+
+const (
+	// RegularBullish is a lower low in price with a higher low in the indicator.
+	RegularBullish DivergenceKind = iota + 1
+	// RegularBearish is a higher high in price with a lower high in the indicator.
+	RegularBearish
+	// HiddenBullish is a higher low in price with a lower low in the indicator.
+	HiddenBullish
+	// HiddenBearish is a lower high in price with a higher high in the indicator.
+	HiddenBearish
+)
+
+func (k DivergenceKind) String() string {
+	switch k {
+	case RegularBullish:
+		return "regular bullish"
+	case RegularBearish:
+		return "regular bearish"
+	case HiddenBullish:
+		return "hidden bullish"
+	case HiddenBearish:
+		return "hidden bearish"
+	}
+	return "unknown"
+}
+
+// Divergence is a divergence between two price pivots and two indicator pivots.
+type Divergence struct {
+	Kind DivergenceKind
+	// PriceIdx are the positions in the input of the first and second price pivots.
+	PriceIdx [2]int
+	// IndicatorIdx are the positions in the input of the first and second indicator pivots.
+	IndicatorIdx [2]int
+}
+
+// DivergenceOptions controls how divergences are detected. Zero values use the defaults.
+type DivergenceOptions struct {
+	// Left and Right are the number of bars before and after a pivot which must not exceed it. The default is 5 for
+	// both. Note that a pivot can only be detected Right bars after it occurs.
+	Left, Right int
+	// MaxDistance is the maximum number of bars between the two pivots of a divergence. The default is 60.
+	MaxDistance int
+	// Tolerance is the maximum number of bars between a price pivot and the matching indicator pivot. The default is 3.
+	Tolerance int
+}
+
+func (o DivergenceOptions) withDefaults() DivergenceOptions {
+	if o.Left <= 0 {
+		o.Left = 5
+	}
+	if o.Right <= 0 {
+		o.Right = 5
+	}
+	if o.MaxDistance <= 0 {
+		o.MaxDistance = 60
+	}
+	if o.Tolerance <= 0 {
+		o.Tolerance = 3
+	}
+	return o
+}
+
+// nearestSwing returns the swing of the given type which is closest to index, and within tolerance of it.
+func nearestSwing(swings []Swing, high bool, index, tolerance int) (Swing, bool) {
+	var best Swing
+	found := false
+	for _, s := range swings {
+		if s.High != high {
+			continue
+		}
+		d := s.Index - index
+		if d < 0 {
+			d = -d
+		}
+		if d > tolerance {
+			continue
+		}
+		bd := best.Index - index
+		if bd < 0 {
+			bd = -bd
+		}
+		if !found || d < bd {
+			best, found = s, true
+		}
+	}
+	return best, found
+}
+
+// Divergences finds divergences between price and an indicator.
+//
+// Swing highs are found in high, and swing lows in low, using Swings. For a series of closing prices, pass it as both
+// high and low.
+// indicator is the output of a function called with the prices, and begIdx the int returned with it, e.g.
+//
+//	rsi, begIdx := talib.Rsi(close, 14, nil)
+//	divs := talib.Divergences(close, close, rsi, begIdx, talib.DivergenceOptions{})
+//
+// Each pair of consecutive price swing highs (or lows) is compared with the indicator swing highs (or lows) nearest to
+// them. The divergences are returned ordered by the position of the second price pivot.
+func Divergences(high, low, indicator []float64, begIdx int, opts DivergenceOptions) []Divergence {
+	opts = opts.withDefaults()
+	priceSwings := Swings(high, low, opts.Left, opts.Right)
+	indSwings := Swings(indicator, indicator, opts.Left, opts.Right)
+	for i := range indSwings {
+		indSwings[i].Index += begIdx
+		indSwings[i].Confirmed += begIdx
+	}
+
+	var divs []Divergence
+	for _, isHigh := range []bool{false, true} {
+		var prev *Swing
+		for i := range priceSwings {
+			p := &priceSwings[i]
+			if p.High != isHigh {
+				continue
+			}
+			p1 := prev
+			prev = p
+			if p1 == nil || p.Index-p1.Index > opts.MaxDistance {
+				continue
+			}
+			i1, ok1 := nearestSwing(indSwings, isHigh, p1.Index, opts.Tolerance)
+			i2, ok2 := nearestSwing(indSwings, isHigh, p.Index, opts.Tolerance)
+			if !ok1 || !ok2 || i1.Index >= i2.Index {
+				continue
+			}
+
+			var kind DivergenceKind
+			switch {
+			case !isHigh && p.Price < p1.Price && i2.Price > i1.Price:
+				kind = RegularBullish
+			case !isHigh && p.Price > p1.Price && i2.Price < i1.Price:
+				kind = HiddenBullish
+			case isHigh && p.Price > p1.Price && i2.Price < i1.Price:
+				kind = RegularBearish
+			case isHigh && p.Price < p1.Price && i2.Price > i1.Price:
+				kind = HiddenBearish
+			default:
+				continue
+			}
+			divs = append(divs, Divergence{
+				Kind:         kind,
+				PriceIdx:     [2]int{p1.Index, p.Index},
+				IndicatorIdx: [2]int{i1.Index, i2.Index},
+			})
+		}
+	}
+	sort.SliceStable(divs, func(i, j int) bool { return divs[i].PriceIdx[1] < divs[j].PriceIdx[1] })
+	return divs
+}
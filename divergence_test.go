@@ -0,0 +1,21 @@
+package talib_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/phemmer/talib"
+)
+
+func TestDivergences(t *testing.T) {
+	// Price makes a lower low at 8 while the indicator makes a higher low.
+	price := []float64{10, 9, 8, 9, 10, 9, 8, 7, 6, 7, 8, 9}
+	ind := []float64{50, 40, 30, 40, 50, 45, 40, 38, 35, 40, 45}
+	divs := talib.Divergences(price, price, ind, 1, talib.DivergenceOptions{Left: 2, Right: 2})
+	expected := []talib.Divergence{
+		{Kind: talib.RegularBullish, PriceIdx: [2]int{2, 8}, IndicatorIdx: [2]int{3, 9}},
+	}
+	if !reflect.DeepEqual(expected, divs) {
+		t.Errorf("Expected %#v got %#v.", expected, divs)
+	}
+}
@@ -0,0 +1,8 @@
+package talib
+
+// SettingsWaiting returns the number of WithSettings calls waiting to be admitted.
+func SettingsWaiting() int {
+	settings.Lock()
+	defer settings.Unlock()
+	return int(settings.next - settings.serving)
+}
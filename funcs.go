@@ -0,0 +1,181 @@
+package talib
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// OptionType is the type of an optional parameter of a function.
+type OptionType int
+
+const (
+	// OptionInteger is an int parameter, such as a time period.
+	OptionInteger OptionType = iota
+	// OptionReal is a float64 parameter.
+	OptionReal
+	// OptionMAType is an int parameter holding one of the TA-Lib MAType constants, MAType_SMA to MAType_T3.
+	OptionMAType
+)
+
+func (t OptionType) String() string {
+	switch t {
+	case OptionInteger:
+		return "integer"
+	case OptionReal:
+		return "real"
+	case OptionMAType:
+		return "MAType"
+	}
+	return fmt.Sprintf("OptionType(%d)", int(t))
+}
+
+// OptionInfo describes an optional parameter of a function.
+type OptionInfo struct {
+	// Name is the name of the Go parameter, e.g. "timePeriod".
+	Name string
+	Type OptionType
+	// Min and Max are the range of valid values.
+	Min, Max float64
+	// Default is the default value used by TA-Lib.
+	Default     float64
+	Description string
+}
+
+// OutputInfo describes an output of a function.
+type OutputInfo struct {
+	// Name is the name of the output, e.g. "upperBand".
+	Name string
+	// Integer is true if the function produces an []int for the output, rather than a []float64.
+	Integer bool
+}
+
+// FuncInfo describes a function of the package, so that it may be called by name.
+type FuncInfo struct {
+	// Name is the TA-Lib name of the function, e.g. "BBANDS".
+	Name string
+	// GoName is the name of the Go function, e.g. "BBands".
+	GoName string
+	// Group is the TA-Lib group of the function, e.g. "Overlap Studies".
+	Group       string
+	Description string
+	// Inputs are the names of the input parameters, e.g. "high", "low", "close".
+	Inputs  []string
+	Options []OptionInfo
+	Outputs []OutputInfo
+
+	fn interface{}
+}
+
+var loadFunctions sync.Once
+
+// Functions returns the functions of the package, ordered by name.
+func Functions() []*FuncInfo {
+	loadFunctions.Do(func() {
+		for _, f := range functions {
+			f.loadAbstract()
+		}
+	})
+	return functions
+}
+
+// LookupFunction returns the function with the given TA-Lib or Go name, matched case-insensitively, or nil if there is
+// no such function.
+func LookupFunction(name string) *FuncInfo {
+	for _, f := range Functions() {
+		if strings.EqualFold(f.Name, name) || strings.EqualFold(f.GoName, name) {
+			return f
+		}
+	}
+	return nil
+}
+
+// Defaults returns the default value of each option.
+func (f *FuncInfo) Defaults() []float64 {
+	opts := make([]float64, len(f.Options))
+	for i, o := range f.Options {
+		opts[i] = o.Default
+	}
+	return opts
+}
+
+// Call calls the function with the given inputs and option values, in the order of Inputs and Options.
+//
+// The outputs are returned in the order of Outputs, with integer outputs converted to float64. The returned int is the
+// position in the input corresponding to the first element of the outputs, as with the function itself.
+func (f *FuncInfo) Call(inputs [][]float64, options []float64) ([][]float64, int, error) {
+	return f.CallInto(inputs, options, nil)
+}
+
+// CallInto is the same as Call, but the outputs are written to the given buffers, in the order of Outputs, rather than
+// newly allocated. Each buffer must be at least as long as the inputs, or nil to allocate it.
+func (f *FuncInfo) CallInto(inputs [][]float64, options []float64, buffers [][]float64) ([][]float64, int, error) {
+	if len(inputs) != len(f.Inputs) {
+		return nil, 0, fmt.Errorf("talib: %s takes %d inputs, got %d", f.Name, len(f.Inputs), len(inputs))
+	}
+	if len(options) != len(f.Options) {
+		return nil, 0, fmt.Errorf("talib: %s takes %d options, got %d", f.Name, len(f.Options), len(options))
+	}
+	if buffers != nil && len(buffers) != len(f.Outputs) {
+		return nil, 0, fmt.Errorf("talib: %s has %d outputs, got %d buffers", f.Name, len(f.Outputs), len(buffers))
+	}
+	for i, in := range inputs {
+		if len(in) == 0 {
+			return nil, 0, fmt.Errorf("talib: %s input %s is empty", f.Name, f.Inputs[i])
+		}
+		if len(in) != len(inputs[0]) {
+			return nil, 0, fmt.Errorf("talib: %s input %s has length %d, expected %d", f.Name, f.Inputs[i], len(in), len(inputs[0]))
+		}
+	}
+
+	fn := reflect.ValueOf(f.fn)
+	args := make([]reflect.Value, 0, fn.Type().NumIn())
+	for _, in := range inputs {
+		args = append(args, reflect.ValueOf(in))
+	}
+	for i, o := range f.Options {
+		v := options[i]
+		if math.IsNaN(v) || v < o.Min || v > o.Max {
+			return nil, 0, fmt.Errorf("talib: %s option %s must be between %v and %v, got %v", f.Name, o.Name, o.Min, o.Max, v)
+		}
+		if o.Type == OptionReal {
+			args = append(args, reflect.ValueOf(v))
+			continue
+		}
+		if v != math.Trunc(v) {
+			return nil, 0, fmt.Errorf("talib: %s option %s must be an integer, got %v", f.Name, o.Name, v)
+		}
+		args = append(args, reflect.ValueOf(int(v)))
+	}
+	for i, o := range f.Outputs {
+		if buffers != nil && buffers[i] != nil && len(buffers[i]) < len(inputs[0]) {
+			return nil, 0, fmt.Errorf("talib: %s buffer %s has length %d, expected at least %d", f.Name, o.Name, len(buffers[i]), len(inputs[0]))
+		}
+		if buffers != nil && !o.Integer {
+			args = append(args, reflect.ValueOf(buffers[i]))
+		} else {
+			args = append(args, reflect.Zero(fn.Type().In(len(args))))
+		}
+	}
+
+	results := fn.Call(args)
+	outputs := make([][]float64, len(f.Outputs))
+	for i, o := range f.Outputs {
+		if !o.Integer {
+			outputs[i] = results[i].Interface().([]float64)
+			continue
+		}
+		ints := results[i].Interface().([]int)
+		if buffers != nil && buffers[i] != nil {
+			outputs[i] = buffers[i][:len(ints)]
+		} else {
+			outputs[i] = make([]float64, len(ints))
+		}
+		for j, v := range ints {
+			outputs[i][j] = float64(v)
+		}
+	}
+	return outputs, int(results[len(results)-1].Int()), nil
+}
@@ -0,0 +1,34 @@
+package talib_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/phemmer/talib"
+)
+
+func TestLookupFunction(t *testing.T) {
+	f := talib.LookupFunction("bbands")
+	if f == nil || f.GoName != "BBands" {
+		t.Fatalf("Expected BBands got %#v.", f)
+	}
+	if len(f.Options) != 4 || f.Options[3].Type != talib.OptionMAType {
+		t.Errorf("Unexpected options %#v.", f.Options)
+	}
+}
+
+func TestFuncInfoCall(t *testing.T) {
+	data := []float64{1, 2, 3, 4, 5}
+	expected, expectedIdx := talib.Sma(data, 3, nil)
+	out, idx, err := talib.LookupFunction("SMA").Call([][]float64{data}, []float64{3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual([][]float64{expected}, out) || idx != expectedIdx {
+		t.Errorf("Expected %#v, %d got %#v, %d.", expected, expectedIdx, out, idx)
+	}
+
+	if _, _, err := talib.LookupFunction("SMA").Call([][]float64{data}, []float64{1}); err == nil {
+		t.Errorf("Expected error for out of range option.")
+	}
+}
@@ -52,7 +52,28 @@ $types = {
   "TA_MAType" => "int"
 }
 
+$ohlcvColumns = {
+  "open" => "Open",
+  "high" => "High",
+  "low" => "Low",
+  "close" => "Close",
+  "volume" => "Volume",
+}
+
+def go_name arg
+  param = arg.match(/(\w*)(\[\])?/)[1]
+  param[0] = param[0].downcase
+  param.gsub("_", "")
+end
+
+def join_words words
+  return words.first if words.length == 1
+  words[0...-1].join(", ") + " and " + words.last
+end
+
 class Func
+  attr_reader :name, :name_raw, :inputs, :opts, :outputs
+
   def initialize comment, func
     #@comment_str = comment
     #@func_str = func
@@ -60,7 +81,149 @@ class Func
     @name = camelize @name_raw
     @args = func.match(/\(.*\)/)[0][1..-2].strip.split(",").map{|a| a.split(" ")}
     @comment = "/*#{@name} - " +comment.gsub(/\/\*(\n\*\s*\w*( - )?)?/, '').gsub(/^\s*\*\s+/,"").gsub(/ +/, " ").gsub(@name_raw, @name).strip.gsub("\n", "\n\n")
+
+    @inputs = []
+    @opts = []
+    @outputs = []
+    @args.each do |arg_set|
+      type = arg_set[-2]
+      arg = arg_set[-1]
+      goType = $types[type] || type
+      if arg.start_with? "optIn"
+        @opts << [go_name(arg[5..-1]), goType, type]
+      elsif arg.start_with? "in"
+        @inputs << go_name(arg[2..-1])
+      elsif arg.start_with?("out") && arg.end_with?("[]")
+        @outputs << [go_name(arg), goType]
+      end
+    end
+  end
+
+  # go_opts returns the optional parameters as they appear in the function signature.
+  def go_opts
+    args = []
+    @opts.each do |name, type|
+      if args.last && args.last.end_with?(" "+type)
+        args[-1] = args.last[0...-(type.length + 1)] + ", " + name + " " + type
+      else
+        args << name + " " + type
+      end
+    end
+    args
   end
+
+  def go_returns
+    "(" + (@outputs.map{|_, type| "[]#{type}"} + ["int"]).join(", ") + ")"
+  end
+
+  # ohlcv_columns returns the OHLCV columns used for the inputs, or nil if the inputs are not price data.
+  def ohlcv_columns
+    return ["Close", "Volume"] if @inputs == ["real", "volume"]
+    cols = @inputs.map{|i| $ohlcvColumns[i]}
+    return nil if cols.include?(nil)
+    cols
+  end
+
+  def to_go_ohlcv
+    cols = ohlcv_columns
+    return nil if !cols
+    params = cols.map{|c| "s.#{c}"} + @opts.map(&:first) + @outputs.map{"nil"}
+    s = "// #{@name} calls #{@name} with the #{join_words(cols)} columns of s.\n"
+    s += "func (s OHLCV) #{@name}(#{go_opts.join(", ")}) #{go_returns} {\n"
+    s += "s.mustValidate(#{cols.map(&:inspect).join(", ")})\n"
+    s += "return #{@name}(#{params.join(", ")})\n"
+    s += "}\n"
+    s
+  end
+
+  # option_info returns the range and description of each optional parameter from the comment.
+  def option_info
+    lines = @comment.split("\n").map(&:strip).reject(&:empty?)
+    info = {}
+    lines.each_with_index do |line, i|
+      if m = line.match(/^optIn(\w+):(\(From (\S+) to (\S+)\))?$/)
+        desc = lines[i+1]
+        desc = nil if desc.nil? || desc.match(/^optIn\w+:/) || desc == "*/"
+        info[go_name(m[1])] = [m[3], m[4], desc]
+      end
+    end
+    info
+  end
+
+  def to_go_info
+    ranges = {"TA_REAL_MIN" => "-math.MaxFloat64", "TA_REAL_MAX" => "math.MaxFloat64"}
+    info = option_info
+    s = "{\n"
+    s += "Name: #{@name_raw.inspect},\n"
+    s += "GoName: #{@name.inspect},\n"
+    s += "Description: #{@comment.split("\n").first.split(" - ", 2).last.strip.inspect},\n"
+    s += "Inputs: []string{#{@inputs.map(&:inspect).join(", ")}},\n"
+    if @opts.length > 0
+      s += "Options: []OptionInfo{\n"
+      @opts.each do |name, goType, type|
+        optType = "OptionInteger"
+        optType = "OptionReal" if goType == "float64"
+        optType = "OptionMAType" if type == "TA_MAType"
+        min, max, desc = info[name]
+        min = min ? (ranges[min] || min) : "0"
+        max = max ? (ranges[max] || max) : "MAType_T3"
+        s += "{Name: #{name.inspect}, Type: #{optType}, Min: #{min}, Max: #{max}, Description: #{desc.to_s.inspect}},\n"
+      end
+      s += "},\n"
+    end
+    s += "Outputs: []OutputInfo{\n"
+    @outputs.each do |name, type|
+      outName = name.sub(/^out/, "")
+      outName = outName[4..-1] if outName.start_with?("Real") && outName.length > 4
+      # Lower case a leading acronym as a whole, e.g. MACDSignal is macdSignal, and MAMA is mama.
+      outName = outName.sub(/^[A-Z]+(?=[A-Z][a-z]|$)/) { |m| m.downcase }
+      outName[0] = outName[0].downcase
+      if type == "int"
+        s += "{Name: #{outName.inspect}, Integer: true},\n"
+      else
+        s += "{Name: #{outName.inspect}},\n"
+      end
+    end
+    s += "},\n"
+    s += "fn: #{@name},\n"
+    s += "}"
+    s
+  end
+
+  def to_go_series
+    return nil if @inputs != ["real"] || @outputs.any?{|_, type| type != "float64"}
+    params = ["s.Values"] + @opts.map(&:first) + @outputs.map{"nil"}
+    returns = @outputs.map{|name, _| "s.Indexed(#{name}, begIdx)"}
+    returnTypes = @outputs.map{"Series"}.join(", ")
+    returnTypes = "(#{returnTypes})" if @outputs.length > 1
+    s = "// #{@name} calls #{@name} with the values of s, and returns the outputs indexed by the times of s.\n"
+    s += "func (s Series) #{@name}(#{go_opts.join(", ")}) #{returnTypes} {\n"
+    s += "#{(@outputs.map(&:first) + ["begIdx"]).join(", ")} := #{@name}(#{params.join(", ")})\n"
+    s += "return #{returns.join(", ")}\n"
+    s += "}\n"
+    s
+  end
+
+  def to_go_aligned
+    n = "len(#{@inputs.first})"
+    args = ["#{@inputs.join(", ")} []float64"] + go_opts + @outputs.map{|name, type| "#{name} []#{type}"}
+    results = @outputs.each_with_index.map{|_, i| i == 0 ? "out" : "_"} + ["begIdx"]
+    returns = @outputs.map do |name, type|
+      align = type == "int" ? "alignInt" : "alignFloat"
+      "#{align}(#{name}, #{n}, begIdx, len(out))"
+    end
+    params = @inputs + @opts.map(&:first) + @outputs.map(&:first)
+    s = "// #{@name}Aligned is the same as #{@name}, but the outputs are aligned with the input. See the package documentation.\n"
+    s += "func #{@name}Aligned(#{args.join(", ")}) #{go_returns} {\n"
+    @outputs.each do |name, type|
+      s += "if #{name} == nil { #{name} = make([]#{type}, #{n}) }\n"
+    end
+    s += "#{results.join(", ")} := #{@name}(#{params.join(", ")})\n"
+    s += "return #{(returns + ["begIdx"]).join(", ")}\n"
+    s += "}\n"
+    s
+  end
+
   def to_go
     args = []
     body = []
@@ -209,3 +372,23 @@ code += funcs.map(&:to_go).join("\n")
 
 File.write("generated.go", code)
 system("go fmt ./generated.go")
+
+code = "package talib\n\n"
+code += funcs.map(&:to_go_ohlcv).compact.join("\n")
+File.write("generated_ohlcv.go", code)
+system("go fmt ./generated_ohlcv.go")
+
+code = "package talib\n\n"
+code += funcs.map(&:to_go_aligned).join("\n")
+File.write("generated_aligned.go", code)
+system("go fmt ./generated_aligned.go")
+
+code = "package talib\n\n"
+code += funcs.map(&:to_go_series).compact.join("\n")
+File.write("generated_series.go", code)
+system("go fmt ./generated_series.go")
+
+code = "package talib\n\nimport \"math\"\n\nvar functions = []*FuncInfo{\n"
+code += funcs.map(&:to_go_info).join(",\n") + ",\n}\n"
+File.write("generated_funcs.go", code)
+system("go fmt ./generated_funcs.go")
@@ -0,0 +1,1474 @@
+package talib
+
+// AcosAligned is the same as Acos, but the outputs are aligned with the input. See the package documentation.
+func AcosAligned(real []float64, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(real))
+	}
+	out, begIdx := Acos(real, outReal)
+	return alignFloat(outReal, len(real), begIdx, len(out)), begIdx
+}
+
+// AdAligned is the same as Ad, but the outputs are aligned with the input. See the package documentation.
+func AdAligned(high, low, close, volume []float64, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(high))
+	}
+	out, begIdx := Ad(high, low, close, volume, outReal)
+	return alignFloat(outReal, len(high), begIdx, len(out)), begIdx
+}
+
+// AddAligned is the same as Add, but the outputs are aligned with the input. See the package documentation.
+func AddAligned(real0, real1 []float64, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(real0))
+	}
+	out, begIdx := Add(real0, real1, outReal)
+	return alignFloat(outReal, len(real0), begIdx, len(out)), begIdx
+}
+
+// AdOscAligned is the same as AdOsc, but the outputs are aligned with the input. See the package documentation.
+func AdOscAligned(high, low, close, volume []float64, fastPeriod, slowPeriod int, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(high))
+	}
+	out, begIdx := AdOsc(high, low, close, volume, fastPeriod, slowPeriod, outReal)
+	return alignFloat(outReal, len(high), begIdx, len(out)), begIdx
+}
+
+// AdxAligned is the same as Adx, but the outputs are aligned with the input. See the package documentation.
+func AdxAligned(high, low, close []float64, timePeriod int, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(high))
+	}
+	out, begIdx := Adx(high, low, close, timePeriod, outReal)
+	return alignFloat(outReal, len(high), begIdx, len(out)), begIdx
+}
+
+// AdxrAligned is the same as Adxr, but the outputs are aligned with the input. See the package documentation.
+func AdxrAligned(high, low, close []float64, timePeriod int, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(high))
+	}
+	out, begIdx := Adxr(high, low, close, timePeriod, outReal)
+	return alignFloat(outReal, len(high), begIdx, len(out)), begIdx
+}
+
+// ApoAligned is the same as Apo, but the outputs are aligned with the input. See the package documentation.
+func ApoAligned(real []float64, fastPeriod, slowPeriod, mAType int, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(real))
+	}
+	out, begIdx := Apo(real, fastPeriod, slowPeriod, mAType, outReal)
+	return alignFloat(outReal, len(real), begIdx, len(out)), begIdx
+}
+
+// AroOnAligned is the same as AroOn, but the outputs are aligned with the input. See the package documentation.
+func AroOnAligned(high, low []float64, timePeriod int, outAroonDown []float64, outAroonUp []float64) ([]float64, []float64, int) {
+	if outAroonDown == nil {
+		outAroonDown = make([]float64, len(high))
+	}
+	if outAroonUp == nil {
+		outAroonUp = make([]float64, len(high))
+	}
+	out, _, begIdx := AroOn(high, low, timePeriod, outAroonDown, outAroonUp)
+	return alignFloat(outAroonDown, len(high), begIdx, len(out)), alignFloat(outAroonUp, len(high), begIdx, len(out)), begIdx
+}
+
+// AroOnOscAligned is the same as AroOnOsc, but the outputs are aligned with the input. See the package documentation.
+func AroOnOscAligned(high, low []float64, timePeriod int, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(high))
+	}
+	out, begIdx := AroOnOsc(high, low, timePeriod, outReal)
+	return alignFloat(outReal, len(high), begIdx, len(out)), begIdx
+}
+
+// AsinAligned is the same as Asin, but the outputs are aligned with the input. See the package documentation.
+func AsinAligned(real []float64, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(real))
+	}
+	out, begIdx := Asin(real, outReal)
+	return alignFloat(outReal, len(real), begIdx, len(out)), begIdx
+}
+
+// AtanAligned is the same as Atan, but the outputs are aligned with the input. See the package documentation.
+func AtanAligned(real []float64, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(real))
+	}
+	out, begIdx := Atan(real, outReal)
+	return alignFloat(outReal, len(real), begIdx, len(out)), begIdx
+}
+
+// AtrAligned is the same as Atr, but the outputs are aligned with the input. See the package documentation.
+func AtrAligned(high, low, close []float64, timePeriod int, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(high))
+	}
+	out, begIdx := Atr(high, low, close, timePeriod, outReal)
+	return alignFloat(outReal, len(high), begIdx, len(out)), begIdx
+}
+
+// AvgPriceAligned is the same as AvgPrice, but the outputs are aligned with the input. See the package documentation.
+func AvgPriceAligned(open, high, low, close []float64, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(open))
+	}
+	out, begIdx := AvgPrice(open, high, low, close, outReal)
+	return alignFloat(outReal, len(open), begIdx, len(out)), begIdx
+}
+
+// BBandsAligned is the same as BBands, but the outputs are aligned with the input. See the package documentation.
+func BBandsAligned(real []float64, timePeriod int, nbDevUp, nbDevDn float64, mAType int, outRealUpperBand []float64, outRealMiddleBand []float64, outRealLowerBand []float64) ([]float64, []float64, []float64, int) {
+	if outRealUpperBand == nil {
+		outRealUpperBand = make([]float64, len(real))
+	}
+	if outRealMiddleBand == nil {
+		outRealMiddleBand = make([]float64, len(real))
+	}
+	if outRealLowerBand == nil {
+		outRealLowerBand = make([]float64, len(real))
+	}
+	out, _, _, begIdx := BBands(real, timePeriod, nbDevUp, nbDevDn, mAType, outRealUpperBand, outRealMiddleBand, outRealLowerBand)
+	return alignFloat(outRealUpperBand, len(real), begIdx, len(out)), alignFloat(outRealMiddleBand, len(real), begIdx, len(out)), alignFloat(outRealLowerBand, len(real), begIdx, len(out)), begIdx
+}
+
+// BetaAligned is the same as Beta, but the outputs are aligned with the input. See the package documentation.
+func BetaAligned(real0, real1 []float64, timePeriod int, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(real0))
+	}
+	out, begIdx := Beta(real0, real1, timePeriod, outReal)
+	return alignFloat(outReal, len(real0), begIdx, len(out)), begIdx
+}
+
+// BopAligned is the same as Bop, but the outputs are aligned with the input. See the package documentation.
+func BopAligned(open, high, low, close []float64, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(open))
+	}
+	out, begIdx := Bop(open, high, low, close, outReal)
+	return alignFloat(outReal, len(open), begIdx, len(out)), begIdx
+}
+
+// CciAligned is the same as Cci, but the outputs are aligned with the input. See the package documentation.
+func CciAligned(high, low, close []float64, timePeriod int, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(high))
+	}
+	out, begIdx := Cci(high, low, close, timePeriod, outReal)
+	return alignFloat(outReal, len(high), begIdx, len(out)), begIdx
+}
+
+// Cdl2CrowsAligned is the same as Cdl2Crows, but the outputs are aligned with the input. See the package documentation.
+func Cdl2CrowsAligned(open, high, low, close []float64, outInteger []int) ([]int, int) {
+	if outInteger == nil {
+		outInteger = make([]int, len(open))
+	}
+	out, begIdx := Cdl2Crows(open, high, low, close, outInteger)
+	return alignInt(outInteger, len(open), begIdx, len(out)), begIdx
+}
+
+// Cdl3BlackCrowsAligned is the same as Cdl3BlackCrows, but the outputs are aligned with the input. See the package documentation.
+func Cdl3BlackCrowsAligned(open, high, low, close []float64, outInteger []int) ([]int, int) {
+	if outInteger == nil {
+		outInteger = make([]int, len(open))
+	}
+	out, begIdx := Cdl3BlackCrows(open, high, low, close, outInteger)
+	return alignInt(outInteger, len(open), begIdx, len(out)), begIdx
+}
+
+// Cdl3InsideAligned is the same as Cdl3Inside, but the outputs are aligned with the input. See the package documentation.
+func Cdl3InsideAligned(open, high, low, close []float64, outInteger []int) ([]int, int) {
+	if outInteger == nil {
+		outInteger = make([]int, len(open))
+	}
+	out, begIdx := Cdl3Inside(open, high, low, close, outInteger)
+	return alignInt(outInteger, len(open), begIdx, len(out)), begIdx
+}
+
+// Cdl3LineStrikeAligned is the same as Cdl3LineStrike, but the outputs are aligned with the input. See the package documentation.
+func Cdl3LineStrikeAligned(open, high, low, close []float64, outInteger []int) ([]int, int) {
+	if outInteger == nil {
+		outInteger = make([]int, len(open))
+	}
+	out, begIdx := Cdl3LineStrike(open, high, low, close, outInteger)
+	return alignInt(outInteger, len(open), begIdx, len(out)), begIdx
+}
+
+// Cdl3OutsideAligned is the same as Cdl3Outside, but the outputs are aligned with the input. See the package documentation.
+func Cdl3OutsideAligned(open, high, low, close []float64, outInteger []int) ([]int, int) {
+	if outInteger == nil {
+		outInteger = make([]int, len(open))
+	}
+	out, begIdx := Cdl3Outside(open, high, low, close, outInteger)
+	return alignInt(outInteger, len(open), begIdx, len(out)), begIdx
+}
+
+// Cdl3StarsinSouthAligned is the same as Cdl3StarsinSouth, but the outputs are aligned with the input. See the package documentation.
+func Cdl3StarsinSouthAligned(open, high, low, close []float64, outInteger []int) ([]int, int) {
+	if outInteger == nil {
+		outInteger = make([]int, len(open))
+	}
+	out, begIdx := Cdl3StarsinSouth(open, high, low, close, outInteger)
+	return alignInt(outInteger, len(open), begIdx, len(out)), begIdx
+}
+
+// Cdl3WhiteSoldiersAligned is the same as Cdl3WhiteSoldiers, but the outputs are aligned with the input. See the package documentation.
+func Cdl3WhiteSoldiersAligned(open, high, low, close []float64, outInteger []int) ([]int, int) {
+	if outInteger == nil {
+		outInteger = make([]int, len(open))
+	}
+	out, begIdx := Cdl3WhiteSoldiers(open, high, low, close, outInteger)
+	return alignInt(outInteger, len(open), begIdx, len(out)), begIdx
+}
+
+// CdlAbandonedBabyAligned is the same as CdlAbandonedBaby, but the outputs are aligned with the input. See the package documentation.
+func CdlAbandonedBabyAligned(open, high, low, close []float64, penetration float64, outInteger []int) ([]int, int) {
+	if outInteger == nil {
+		outInteger = make([]int, len(open))
+	}
+	out, begIdx := CdlAbandonedBaby(open, high, low, close, penetration, outInteger)
+	return alignInt(outInteger, len(open), begIdx, len(out)), begIdx
+}
+
+// CdlAdvanceBlockAligned is the same as CdlAdvanceBlock, but the outputs are aligned with the input. See the package documentation.
+func CdlAdvanceBlockAligned(open, high, low, close []float64, outInteger []int) ([]int, int) {
+	if outInteger == nil {
+		outInteger = make([]int, len(open))
+	}
+	out, begIdx := CdlAdvanceBlock(open, high, low, close, outInteger)
+	return alignInt(outInteger, len(open), begIdx, len(out)), begIdx
+}
+
+// CdlBeltholdAligned is the same as CdlBelthold, but the outputs are aligned with the input. See the package documentation.
+func CdlBeltholdAligned(open, high, low, close []float64, outInteger []int) ([]int, int) {
+	if outInteger == nil {
+		outInteger = make([]int, len(open))
+	}
+	out, begIdx := CdlBelthold(open, high, low, close, outInteger)
+	return alignInt(outInteger, len(open), begIdx, len(out)), begIdx
+}
+
+// CdlBreakawayAligned is the same as CdlBreakaway, but the outputs are aligned with the input. See the package documentation.
+func CdlBreakawayAligned(open, high, low, close []float64, outInteger []int) ([]int, int) {
+	if outInteger == nil {
+		outInteger = make([]int, len(open))
+	}
+	out, begIdx := CdlBreakaway(open, high, low, close, outInteger)
+	return alignInt(outInteger, len(open), begIdx, len(out)), begIdx
+}
+
+// CdlClosingMarubozuAligned is the same as CdlClosingMarubozu, but the outputs are aligned with the input. See the package documentation.
+func CdlClosingMarubozuAligned(open, high, low, close []float64, outInteger []int) ([]int, int) {
+	if outInteger == nil {
+		outInteger = make([]int, len(open))
+	}
+	out, begIdx := CdlClosingMarubozu(open, high, low, close, outInteger)
+	return alignInt(outInteger, len(open), begIdx, len(out)), begIdx
+}
+
+// CdlConcealBabySwallAligned is the same as CdlConcealBabySwall, but the outputs are aligned with the input. See the package documentation.
+func CdlConcealBabySwallAligned(open, high, low, close []float64, outInteger []int) ([]int, int) {
+	if outInteger == nil {
+		outInteger = make([]int, len(open))
+	}
+	out, begIdx := CdlConcealBabySwall(open, high, low, close, outInteger)
+	return alignInt(outInteger, len(open), begIdx, len(out)), begIdx
+}
+
+// CdlCounterattackAligned is the same as CdlCounterattack, but the outputs are aligned with the input. See the package documentation.
+func CdlCounterattackAligned(open, high, low, close []float64, outInteger []int) ([]int, int) {
+	if outInteger == nil {
+		outInteger = make([]int, len(open))
+	}
+	out, begIdx := CdlCounterattack(open, high, low, close, outInteger)
+	return alignInt(outInteger, len(open), begIdx, len(out)), begIdx
+}
+
+// CdlDarkCloudCoverAligned is the same as CdlDarkCloudCover, but the outputs are aligned with the input. See the package documentation.
+func CdlDarkCloudCoverAligned(open, high, low, close []float64, penetration float64, outInteger []int) ([]int, int) {
+	if outInteger == nil {
+		outInteger = make([]int, len(open))
+	}
+	out, begIdx := CdlDarkCloudCover(open, high, low, close, penetration, outInteger)
+	return alignInt(outInteger, len(open), begIdx, len(out)), begIdx
+}
+
+// CdlDojiAligned is the same as CdlDoji, but the outputs are aligned with the input. See the package documentation.
+func CdlDojiAligned(open, high, low, close []float64, outInteger []int) ([]int, int) {
+	if outInteger == nil {
+		outInteger = make([]int, len(open))
+	}
+	out, begIdx := CdlDoji(open, high, low, close, outInteger)
+	return alignInt(outInteger, len(open), begIdx, len(out)), begIdx
+}
+
+// CdlDojiStarAligned is the same as CdlDojiStar, but the outputs are aligned with the input. See the package documentation.
+func CdlDojiStarAligned(open, high, low, close []float64, outInteger []int) ([]int, int) {
+	if outInteger == nil {
+		outInteger = make([]int, len(open))
+	}
+	out, begIdx := CdlDojiStar(open, high, low, close, outInteger)
+	return alignInt(outInteger, len(open), begIdx, len(out)), begIdx
+}
+
+// CdlDragonflyDojiAligned is the same as CdlDragonflyDoji, but the outputs are aligned with the input. See the package documentation.
+func CdlDragonflyDojiAligned(open, high, low, close []float64, outInteger []int) ([]int, int) {
+	if outInteger == nil {
+		outInteger = make([]int, len(open))
+	}
+	out, begIdx := CdlDragonflyDoji(open, high, low, close, outInteger)
+	return alignInt(outInteger, len(open), begIdx, len(out)), begIdx
+}
+
+// CdlEngulfingAligned is the same as CdlEngulfing, but the outputs are aligned with the input. See the package documentation.
+func CdlEngulfingAligned(open, high, low, close []float64, outInteger []int) ([]int, int) {
+	if outInteger == nil {
+		outInteger = make([]int, len(open))
+	}
+	out, begIdx := CdlEngulfing(open, high, low, close, outInteger)
+	return alignInt(outInteger, len(open), begIdx, len(out)), begIdx
+}
+
+// CdlEveningDojiStarAligned is the same as CdlEveningDojiStar, but the outputs are aligned with the input. See the package documentation.
+func CdlEveningDojiStarAligned(open, high, low, close []float64, penetration float64, outInteger []int) ([]int, int) {
+	if outInteger == nil {
+		outInteger = make([]int, len(open))
+	}
+	out, begIdx := CdlEveningDojiStar(open, high, low, close, penetration, outInteger)
+	return alignInt(outInteger, len(open), begIdx, len(out)), begIdx
+}
+
+// CdlEveningStarAligned is the same as CdlEveningStar, but the outputs are aligned with the input. See the package documentation.
+func CdlEveningStarAligned(open, high, low, close []float64, penetration float64, outInteger []int) ([]int, int) {
+	if outInteger == nil {
+		outInteger = make([]int, len(open))
+	}
+	out, begIdx := CdlEveningStar(open, high, low, close, penetration, outInteger)
+	return alignInt(outInteger, len(open), begIdx, len(out)), begIdx
+}
+
+// CdlGapSidesideWhiteAligned is the same as CdlGapSidesideWhite, but the outputs are aligned with the input. See the package documentation.
+func CdlGapSidesideWhiteAligned(open, high, low, close []float64, outInteger []int) ([]int, int) {
+	if outInteger == nil {
+		outInteger = make([]int, len(open))
+	}
+	out, begIdx := CdlGapSidesideWhite(open, high, low, close, outInteger)
+	return alignInt(outInteger, len(open), begIdx, len(out)), begIdx
+}
+
+// CdlGravestoneDojiAligned is the same as CdlGravestoneDoji, but the outputs are aligned with the input. See the package documentation.
+func CdlGravestoneDojiAligned(open, high, low, close []float64, outInteger []int) ([]int, int) {
+	if outInteger == nil {
+		outInteger = make([]int, len(open))
+	}
+	out, begIdx := CdlGravestoneDoji(open, high, low, close, outInteger)
+	return alignInt(outInteger, len(open), begIdx, len(out)), begIdx
+}
+
+// CdlHammerAligned is the same as CdlHammer, but the outputs are aligned with the input. See the package documentation.
+func CdlHammerAligned(open, high, low, close []float64, outInteger []int) ([]int, int) {
+	if outInteger == nil {
+		outInteger = make([]int, len(open))
+	}
+	out, begIdx := CdlHammer(open, high, low, close, outInteger)
+	return alignInt(outInteger, len(open), begIdx, len(out)), begIdx
+}
+
+// CdlHangingManAligned is the same as CdlHangingMan, but the outputs are aligned with the input. See the package documentation.
+func CdlHangingManAligned(open, high, low, close []float64, outInteger []int) ([]int, int) {
+	if outInteger == nil {
+		outInteger = make([]int, len(open))
+	}
+	out, begIdx := CdlHangingMan(open, high, low, close, outInteger)
+	return alignInt(outInteger, len(open), begIdx, len(out)), begIdx
+}
+
+// CdlHaramiAligned is the same as CdlHarami, but the outputs are aligned with the input. See the package documentation.
+func CdlHaramiAligned(open, high, low, close []float64, outInteger []int) ([]int, int) {
+	if outInteger == nil {
+		outInteger = make([]int, len(open))
+	}
+	out, begIdx := CdlHarami(open, high, low, close, outInteger)
+	return alignInt(outInteger, len(open), begIdx, len(out)), begIdx
+}
+
+// CdlHaramiCrossAligned is the same as CdlHaramiCross, but the outputs are aligned with the input. See the package documentation.
+func CdlHaramiCrossAligned(open, high, low, close []float64, outInteger []int) ([]int, int) {
+	if outInteger == nil {
+		outInteger = make([]int, len(open))
+	}
+	out, begIdx := CdlHaramiCross(open, high, low, close, outInteger)
+	return alignInt(outInteger, len(open), begIdx, len(out)), begIdx
+}
+
+// CdlHighWaveAligned is the same as CdlHighWave, but the outputs are aligned with the input. See the package documentation.
+func CdlHighWaveAligned(open, high, low, close []float64, outInteger []int) ([]int, int) {
+	if outInteger == nil {
+		outInteger = make([]int, len(open))
+	}
+	out, begIdx := CdlHighWave(open, high, low, close, outInteger)
+	return alignInt(outInteger, len(open), begIdx, len(out)), begIdx
+}
+
+// CdlHikkakeAligned is the same as CdlHikkake, but the outputs are aligned with the input. See the package documentation.
+func CdlHikkakeAligned(open, high, low, close []float64, outInteger []int) ([]int, int) {
+	if outInteger == nil {
+		outInteger = make([]int, len(open))
+	}
+	out, begIdx := CdlHikkake(open, high, low, close, outInteger)
+	return alignInt(outInteger, len(open), begIdx, len(out)), begIdx
+}
+
+// CdlHikkakeModAligned is the same as CdlHikkakeMod, but the outputs are aligned with the input. See the package documentation.
+func CdlHikkakeModAligned(open, high, low, close []float64, outInteger []int) ([]int, int) {
+	if outInteger == nil {
+		outInteger = make([]int, len(open))
+	}
+	out, begIdx := CdlHikkakeMod(open, high, low, close, outInteger)
+	return alignInt(outInteger, len(open), begIdx, len(out)), begIdx
+}
+
+// CdlHomingPigeonAligned is the same as CdlHomingPigeon, but the outputs are aligned with the input. See the package documentation.
+func CdlHomingPigeonAligned(open, high, low, close []float64, outInteger []int) ([]int, int) {
+	if outInteger == nil {
+		outInteger = make([]int, len(open))
+	}
+	out, begIdx := CdlHomingPigeon(open, high, low, close, outInteger)
+	return alignInt(outInteger, len(open), begIdx, len(out)), begIdx
+}
+
+// CdlIdentical3CrowsAligned is the same as CdlIdentical3Crows, but the outputs are aligned with the input. See the package documentation.
+func CdlIdentical3CrowsAligned(open, high, low, close []float64, outInteger []int) ([]int, int) {
+	if outInteger == nil {
+		outInteger = make([]int, len(open))
+	}
+	out, begIdx := CdlIdentical3Crows(open, high, low, close, outInteger)
+	return alignInt(outInteger, len(open), begIdx, len(out)), begIdx
+}
+
+// CdlInNeckAligned is the same as CdlInNeck, but the outputs are aligned with the input. See the package documentation.
+func CdlInNeckAligned(open, high, low, close []float64, outInteger []int) ([]int, int) {
+	if outInteger == nil {
+		outInteger = make([]int, len(open))
+	}
+	out, begIdx := CdlInNeck(open, high, low, close, outInteger)
+	return alignInt(outInteger, len(open), begIdx, len(out)), begIdx
+}
+
+// CdlInvertedHammerAligned is the same as CdlInvertedHammer, but the outputs are aligned with the input. See the package documentation.
+func CdlInvertedHammerAligned(open, high, low, close []float64, outInteger []int) ([]int, int) {
+	if outInteger == nil {
+		outInteger = make([]int, len(open))
+	}
+	out, begIdx := CdlInvertedHammer(open, high, low, close, outInteger)
+	return alignInt(outInteger, len(open), begIdx, len(out)), begIdx
+}
+
+// CdlKickingAligned is the same as CdlKicking, but the outputs are aligned with the input. See the package documentation.
+func CdlKickingAligned(open, high, low, close []float64, outInteger []int) ([]int, int) {
+	if outInteger == nil {
+		outInteger = make([]int, len(open))
+	}
+	out, begIdx := CdlKicking(open, high, low, close, outInteger)
+	return alignInt(outInteger, len(open), begIdx, len(out)), begIdx
+}
+
+// CdlKickingByLengthAligned is the same as CdlKickingByLength, but the outputs are aligned with the input. See the package documentation.
+func CdlKickingByLengthAligned(open, high, low, close []float64, outInteger []int) ([]int, int) {
+	if outInteger == nil {
+		outInteger = make([]int, len(open))
+	}
+	out, begIdx := CdlKickingByLength(open, high, low, close, outInteger)
+	return alignInt(outInteger, len(open), begIdx, len(out)), begIdx
+}
+
+// CdlLadderBottomAligned is the same as CdlLadderBottom, but the outputs are aligned with the input. See the package documentation.
+func CdlLadderBottomAligned(open, high, low, close []float64, outInteger []int) ([]int, int) {
+	if outInteger == nil {
+		outInteger = make([]int, len(open))
+	}
+	out, begIdx := CdlLadderBottom(open, high, low, close, outInteger)
+	return alignInt(outInteger, len(open), begIdx, len(out)), begIdx
+}
+
+// CdlLongLeggedDojiAligned is the same as CdlLongLeggedDoji, but the outputs are aligned with the input. See the package documentation.
+func CdlLongLeggedDojiAligned(open, high, low, close []float64, outInteger []int) ([]int, int) {
+	if outInteger == nil {
+		outInteger = make([]int, len(open))
+	}
+	out, begIdx := CdlLongLeggedDoji(open, high, low, close, outInteger)
+	return alignInt(outInteger, len(open), begIdx, len(out)), begIdx
+}
+
+// CdlLongLineAligned is the same as CdlLongLine, but the outputs are aligned with the input. See the package documentation.
+func CdlLongLineAligned(open, high, low, close []float64, outInteger []int) ([]int, int) {
+	if outInteger == nil {
+		outInteger = make([]int, len(open))
+	}
+	out, begIdx := CdlLongLine(open, high, low, close, outInteger)
+	return alignInt(outInteger, len(open), begIdx, len(out)), begIdx
+}
+
+// CdlMarubozuAligned is the same as CdlMarubozu, but the outputs are aligned with the input. See the package documentation.
+func CdlMarubozuAligned(open, high, low, close []float64, outInteger []int) ([]int, int) {
+	if outInteger == nil {
+		outInteger = make([]int, len(open))
+	}
+	out, begIdx := CdlMarubozu(open, high, low, close, outInteger)
+	return alignInt(outInteger, len(open), begIdx, len(out)), begIdx
+}
+
+// CdlMatchingLowAligned is the same as CdlMatchingLow, but the outputs are aligned with the input. See the package documentation.
+func CdlMatchingLowAligned(open, high, low, close []float64, outInteger []int) ([]int, int) {
+	if outInteger == nil {
+		outInteger = make([]int, len(open))
+	}
+	out, begIdx := CdlMatchingLow(open, high, low, close, outInteger)
+	return alignInt(outInteger, len(open), begIdx, len(out)), begIdx
+}
+
+// CdlMatHoldAligned is the same as CdlMatHold, but the outputs are aligned with the input. See the package documentation.
+func CdlMatHoldAligned(open, high, low, close []float64, penetration float64, outInteger []int) ([]int, int) {
+	if outInteger == nil {
+		outInteger = make([]int, len(open))
+	}
+	out, begIdx := CdlMatHold(open, high, low, close, penetration, outInteger)
+	return alignInt(outInteger, len(open), begIdx, len(out)), begIdx
+}
+
+// CdlMorningDojiStarAligned is the same as CdlMorningDojiStar, but the outputs are aligned with the input. See the package documentation.
+func CdlMorningDojiStarAligned(open, high, low, close []float64, penetration float64, outInteger []int) ([]int, int) {
+	if outInteger == nil {
+		outInteger = make([]int, len(open))
+	}
+	out, begIdx := CdlMorningDojiStar(open, high, low, close, penetration, outInteger)
+	return alignInt(outInteger, len(open), begIdx, len(out)), begIdx
+}
+
+// CdlMorningStarAligned is the same as CdlMorningStar, but the outputs are aligned with the input. See the package documentation.
+func CdlMorningStarAligned(open, high, low, close []float64, penetration float64, outInteger []int) ([]int, int) {
+	if outInteger == nil {
+		outInteger = make([]int, len(open))
+	}
+	out, begIdx := CdlMorningStar(open, high, low, close, penetration, outInteger)
+	return alignInt(outInteger, len(open), begIdx, len(out)), begIdx
+}
+
+// CdlOnNeckAligned is the same as CdlOnNeck, but the outputs are aligned with the input. See the package documentation.
+func CdlOnNeckAligned(open, high, low, close []float64, outInteger []int) ([]int, int) {
+	if outInteger == nil {
+		outInteger = make([]int, len(open))
+	}
+	out, begIdx := CdlOnNeck(open, high, low, close, outInteger)
+	return alignInt(outInteger, len(open), begIdx, len(out)), begIdx
+}
+
+// CdlPiercingAligned is the same as CdlPiercing, but the outputs are aligned with the input. See the package documentation.
+func CdlPiercingAligned(open, high, low, close []float64, outInteger []int) ([]int, int) {
+	if outInteger == nil {
+		outInteger = make([]int, len(open))
+	}
+	out, begIdx := CdlPiercing(open, high, low, close, outInteger)
+	return alignInt(outInteger, len(open), begIdx, len(out)), begIdx
+}
+
+// CdlRickshawManAligned is the same as CdlRickshawMan, but the outputs are aligned with the input. See the package documentation.
+func CdlRickshawManAligned(open, high, low, close []float64, outInteger []int) ([]int, int) {
+	if outInteger == nil {
+		outInteger = make([]int, len(open))
+	}
+	out, begIdx := CdlRickshawMan(open, high, low, close, outInteger)
+	return alignInt(outInteger, len(open), begIdx, len(out)), begIdx
+}
+
+// CdlRiseFall3MethodsAligned is the same as CdlRiseFall3Methods, but the outputs are aligned with the input. See the package documentation.
+func CdlRiseFall3MethodsAligned(open, high, low, close []float64, outInteger []int) ([]int, int) {
+	if outInteger == nil {
+		outInteger = make([]int, len(open))
+	}
+	out, begIdx := CdlRiseFall3Methods(open, high, low, close, outInteger)
+	return alignInt(outInteger, len(open), begIdx, len(out)), begIdx
+}
+
+// CdlSeparatingLinesAligned is the same as CdlSeparatingLines, but the outputs are aligned with the input. See the package documentation.
+func CdlSeparatingLinesAligned(open, high, low, close []float64, outInteger []int) ([]int, int) {
+	if outInteger == nil {
+		outInteger = make([]int, len(open))
+	}
+	out, begIdx := CdlSeparatingLines(open, high, low, close, outInteger)
+	return alignInt(outInteger, len(open), begIdx, len(out)), begIdx
+}
+
+// CdlShootingStarAligned is the same as CdlShootingStar, but the outputs are aligned with the input. See the package documentation.
+func CdlShootingStarAligned(open, high, low, close []float64, outInteger []int) ([]int, int) {
+	if outInteger == nil {
+		outInteger = make([]int, len(open))
+	}
+	out, begIdx := CdlShootingStar(open, high, low, close, outInteger)
+	return alignInt(outInteger, len(open), begIdx, len(out)), begIdx
+}
+
+// CdlShortLineAligned is the same as CdlShortLine, but the outputs are aligned with the input. See the package documentation.
+func CdlShortLineAligned(open, high, low, close []float64, outInteger []int) ([]int, int) {
+	if outInteger == nil {
+		outInteger = make([]int, len(open))
+	}
+	out, begIdx := CdlShortLine(open, high, low, close, outInteger)
+	return alignInt(outInteger, len(open), begIdx, len(out)), begIdx
+}
+
+// CdlSpinningTopAligned is the same as CdlSpinningTop, but the outputs are aligned with the input. See the package documentation.
+func CdlSpinningTopAligned(open, high, low, close []float64, outInteger []int) ([]int, int) {
+	if outInteger == nil {
+		outInteger = make([]int, len(open))
+	}
+	out, begIdx := CdlSpinningTop(open, high, low, close, outInteger)
+	return alignInt(outInteger, len(open), begIdx, len(out)), begIdx
+}
+
+// CdlStalledPatternAligned is the same as CdlStalledPattern, but the outputs are aligned with the input. See the package documentation.
+func CdlStalledPatternAligned(open, high, low, close []float64, outInteger []int) ([]int, int) {
+	if outInteger == nil {
+		outInteger = make([]int, len(open))
+	}
+	out, begIdx := CdlStalledPattern(open, high, low, close, outInteger)
+	return alignInt(outInteger, len(open), begIdx, len(out)), begIdx
+}
+
+// CdlStickSandwichAligned is the same as CdlStickSandwich, but the outputs are aligned with the input. See the package documentation.
+func CdlStickSandwichAligned(open, high, low, close []float64, outInteger []int) ([]int, int) {
+	if outInteger == nil {
+		outInteger = make([]int, len(open))
+	}
+	out, begIdx := CdlStickSandwich(open, high, low, close, outInteger)
+	return alignInt(outInteger, len(open), begIdx, len(out)), begIdx
+}
+
+// CdlTakuriAligned is the same as CdlTakuri, but the outputs are aligned with the input. See the package documentation.
+func CdlTakuriAligned(open, high, low, close []float64, outInteger []int) ([]int, int) {
+	if outInteger == nil {
+		outInteger = make([]int, len(open))
+	}
+	out, begIdx := CdlTakuri(open, high, low, close, outInteger)
+	return alignInt(outInteger, len(open), begIdx, len(out)), begIdx
+}
+
+// CdlTasukiGapAligned is the same as CdlTasukiGap, but the outputs are aligned with the input. See the package documentation.
+func CdlTasukiGapAligned(open, high, low, close []float64, outInteger []int) ([]int, int) {
+	if outInteger == nil {
+		outInteger = make([]int, len(open))
+	}
+	out, begIdx := CdlTasukiGap(open, high, low, close, outInteger)
+	return alignInt(outInteger, len(open), begIdx, len(out)), begIdx
+}
+
+// CdlThrustingAligned is the same as CdlThrusting, but the outputs are aligned with the input. See the package documentation.
+func CdlThrustingAligned(open, high, low, close []float64, outInteger []int) ([]int, int) {
+	if outInteger == nil {
+		outInteger = make([]int, len(open))
+	}
+	out, begIdx := CdlThrusting(open, high, low, close, outInteger)
+	return alignInt(outInteger, len(open), begIdx, len(out)), begIdx
+}
+
+// CdlTristarAligned is the same as CdlTristar, but the outputs are aligned with the input. See the package documentation.
+func CdlTristarAligned(open, high, low, close []float64, outInteger []int) ([]int, int) {
+	if outInteger == nil {
+		outInteger = make([]int, len(open))
+	}
+	out, begIdx := CdlTristar(open, high, low, close, outInteger)
+	return alignInt(outInteger, len(open), begIdx, len(out)), begIdx
+}
+
+// CdlUnique3RiverAligned is the same as CdlUnique3River, but the outputs are aligned with the input. See the package documentation.
+func CdlUnique3RiverAligned(open, high, low, close []float64, outInteger []int) ([]int, int) {
+	if outInteger == nil {
+		outInteger = make([]int, len(open))
+	}
+	out, begIdx := CdlUnique3River(open, high, low, close, outInteger)
+	return alignInt(outInteger, len(open), begIdx, len(out)), begIdx
+}
+
+// CdlUpsideGap2CrowsAligned is the same as CdlUpsideGap2Crows, but the outputs are aligned with the input. See the package documentation.
+func CdlUpsideGap2CrowsAligned(open, high, low, close []float64, outInteger []int) ([]int, int) {
+	if outInteger == nil {
+		outInteger = make([]int, len(open))
+	}
+	out, begIdx := CdlUpsideGap2Crows(open, high, low, close, outInteger)
+	return alignInt(outInteger, len(open), begIdx, len(out)), begIdx
+}
+
+// CdlxSideGap3MethodsAligned is the same as CdlxSideGap3Methods, but the outputs are aligned with the input. See the package documentation.
+func CdlxSideGap3MethodsAligned(open, high, low, close []float64, outInteger []int) ([]int, int) {
+	if outInteger == nil {
+		outInteger = make([]int, len(open))
+	}
+	out, begIdx := CdlxSideGap3Methods(open, high, low, close, outInteger)
+	return alignInt(outInteger, len(open), begIdx, len(out)), begIdx
+}
+
+// CeilAligned is the same as Ceil, but the outputs are aligned with the input. See the package documentation.
+func CeilAligned(real []float64, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(real))
+	}
+	out, begIdx := Ceil(real, outReal)
+	return alignFloat(outReal, len(real), begIdx, len(out)), begIdx
+}
+
+// CmoAligned is the same as Cmo, but the outputs are aligned with the input. See the package documentation.
+func CmoAligned(real []float64, timePeriod int, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(real))
+	}
+	out, begIdx := Cmo(real, timePeriod, outReal)
+	return alignFloat(outReal, len(real), begIdx, len(out)), begIdx
+}
+
+// CorrelAligned is the same as Correl, but the outputs are aligned with the input. See the package documentation.
+func CorrelAligned(real0, real1 []float64, timePeriod int, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(real0))
+	}
+	out, begIdx := Correl(real0, real1, timePeriod, outReal)
+	return alignFloat(outReal, len(real0), begIdx, len(out)), begIdx
+}
+
+// CosAligned is the same as Cos, but the outputs are aligned with the input. See the package documentation.
+func CosAligned(real []float64, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(real))
+	}
+	out, begIdx := Cos(real, outReal)
+	return alignFloat(outReal, len(real), begIdx, len(out)), begIdx
+}
+
+// CoshAligned is the same as Cosh, but the outputs are aligned with the input. See the package documentation.
+func CoshAligned(real []float64, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(real))
+	}
+	out, begIdx := Cosh(real, outReal)
+	return alignFloat(outReal, len(real), begIdx, len(out)), begIdx
+}
+
+// DemaAligned is the same as Dema, but the outputs are aligned with the input. See the package documentation.
+func DemaAligned(real []float64, timePeriod int, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(real))
+	}
+	out, begIdx := Dema(real, timePeriod, outReal)
+	return alignFloat(outReal, len(real), begIdx, len(out)), begIdx
+}
+
+// DivAligned is the same as Div, but the outputs are aligned with the input. See the package documentation.
+func DivAligned(real0, real1 []float64, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(real0))
+	}
+	out, begIdx := Div(real0, real1, outReal)
+	return alignFloat(outReal, len(real0), begIdx, len(out)), begIdx
+}
+
+// DxAligned is the same as Dx, but the outputs are aligned with the input. See the package documentation.
+func DxAligned(high, low, close []float64, timePeriod int, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(high))
+	}
+	out, begIdx := Dx(high, low, close, timePeriod, outReal)
+	return alignFloat(outReal, len(high), begIdx, len(out)), begIdx
+}
+
+// EmaAligned is the same as Ema, but the outputs are aligned with the input. See the package documentation.
+func EmaAligned(real []float64, timePeriod int, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(real))
+	}
+	out, begIdx := Ema(real, timePeriod, outReal)
+	return alignFloat(outReal, len(real), begIdx, len(out)), begIdx
+}
+
+// ExpAligned is the same as Exp, but the outputs are aligned with the input. See the package documentation.
+func ExpAligned(real []float64, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(real))
+	}
+	out, begIdx := Exp(real, outReal)
+	return alignFloat(outReal, len(real), begIdx, len(out)), begIdx
+}
+
+// FloorAligned is the same as Floor, but the outputs are aligned with the input. See the package documentation.
+func FloorAligned(real []float64, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(real))
+	}
+	out, begIdx := Floor(real, outReal)
+	return alignFloat(outReal, len(real), begIdx, len(out)), begIdx
+}
+
+// HtDcPeriodAligned is the same as HtDcPeriod, but the outputs are aligned with the input. See the package documentation.
+func HtDcPeriodAligned(real []float64, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(real))
+	}
+	out, begIdx := HtDcPeriod(real, outReal)
+	return alignFloat(outReal, len(real), begIdx, len(out)), begIdx
+}
+
+// HtDcPhaseAligned is the same as HtDcPhase, but the outputs are aligned with the input. See the package documentation.
+func HtDcPhaseAligned(real []float64, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(real))
+	}
+	out, begIdx := HtDcPhase(real, outReal)
+	return alignFloat(outReal, len(real), begIdx, len(out)), begIdx
+}
+
+// HtPhasorAligned is the same as HtPhasor, but the outputs are aligned with the input. See the package documentation.
+func HtPhasorAligned(real []float64, outInPhase []float64, outQuadrature []float64) ([]float64, []float64, int) {
+	if outInPhase == nil {
+		outInPhase = make([]float64, len(real))
+	}
+	if outQuadrature == nil {
+		outQuadrature = make([]float64, len(real))
+	}
+	out, _, begIdx := HtPhasor(real, outInPhase, outQuadrature)
+	return alignFloat(outInPhase, len(real), begIdx, len(out)), alignFloat(outQuadrature, len(real), begIdx, len(out)), begIdx
+}
+
+// HtSineAligned is the same as HtSine, but the outputs are aligned with the input. See the package documentation.
+func HtSineAligned(real []float64, outSine []float64, outLeadSine []float64) ([]float64, []float64, int) {
+	if outSine == nil {
+		outSine = make([]float64, len(real))
+	}
+	if outLeadSine == nil {
+		outLeadSine = make([]float64, len(real))
+	}
+	out, _, begIdx := HtSine(real, outSine, outLeadSine)
+	return alignFloat(outSine, len(real), begIdx, len(out)), alignFloat(outLeadSine, len(real), begIdx, len(out)), begIdx
+}
+
+// HtTrendLineAligned is the same as HtTrendLine, but the outputs are aligned with the input. See the package documentation.
+func HtTrendLineAligned(real []float64, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(real))
+	}
+	out, begIdx := HtTrendLine(real, outReal)
+	return alignFloat(outReal, len(real), begIdx, len(out)), begIdx
+}
+
+// HtTrendModeAligned is the same as HtTrendMode, but the outputs are aligned with the input. See the package documentation.
+func HtTrendModeAligned(real []float64, outInteger []int) ([]int, int) {
+	if outInteger == nil {
+		outInteger = make([]int, len(real))
+	}
+	out, begIdx := HtTrendMode(real, outInteger)
+	return alignInt(outInteger, len(real), begIdx, len(out)), begIdx
+}
+
+// KamaAligned is the same as Kama, but the outputs are aligned with the input. See the package documentation.
+func KamaAligned(real []float64, timePeriod int, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(real))
+	}
+	out, begIdx := Kama(real, timePeriod, outReal)
+	return alignFloat(outReal, len(real), begIdx, len(out)), begIdx
+}
+
+// LinearRegAligned is the same as LinearReg, but the outputs are aligned with the input. See the package documentation.
+func LinearRegAligned(real []float64, timePeriod int, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(real))
+	}
+	out, begIdx := LinearReg(real, timePeriod, outReal)
+	return alignFloat(outReal, len(real), begIdx, len(out)), begIdx
+}
+
+// LinearRegAngleAligned is the same as LinearRegAngle, but the outputs are aligned with the input. See the package documentation.
+func LinearRegAngleAligned(real []float64, timePeriod int, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(real))
+	}
+	out, begIdx := LinearRegAngle(real, timePeriod, outReal)
+	return alignFloat(outReal, len(real), begIdx, len(out)), begIdx
+}
+
+// LinearRegInterceptAligned is the same as LinearRegIntercept, but the outputs are aligned with the input. See the package documentation.
+func LinearRegInterceptAligned(real []float64, timePeriod int, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(real))
+	}
+	out, begIdx := LinearRegIntercept(real, timePeriod, outReal)
+	return alignFloat(outReal, len(real), begIdx, len(out)), begIdx
+}
+
+// LinearRegSlopeAligned is the same as LinearRegSlope, but the outputs are aligned with the input. See the package documentation.
+func LinearRegSlopeAligned(real []float64, timePeriod int, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(real))
+	}
+	out, begIdx := LinearRegSlope(real, timePeriod, outReal)
+	return alignFloat(outReal, len(real), begIdx, len(out)), begIdx
+}
+
+// LnAligned is the same as Ln, but the outputs are aligned with the input. See the package documentation.
+func LnAligned(real []float64, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(real))
+	}
+	out, begIdx := Ln(real, outReal)
+	return alignFloat(outReal, len(real), begIdx, len(out)), begIdx
+}
+
+// Log10Aligned is the same as Log10, but the outputs are aligned with the input. See the package documentation.
+func Log10Aligned(real []float64, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(real))
+	}
+	out, begIdx := Log10(real, outReal)
+	return alignFloat(outReal, len(real), begIdx, len(out)), begIdx
+}
+
+// MaAligned is the same as Ma, but the outputs are aligned with the input. See the package documentation.
+func MaAligned(real []float64, timePeriod, mAType int, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(real))
+	}
+	out, begIdx := Ma(real, timePeriod, mAType, outReal)
+	return alignFloat(outReal, len(real), begIdx, len(out)), begIdx
+}
+
+// MacdAligned is the same as Macd, but the outputs are aligned with the input. See the package documentation.
+func MacdAligned(real []float64, fastPeriod, slowPeriod, signalPeriod int, outMACD []float64, outMACDSignal []float64, outMACDHist []float64) ([]float64, []float64, []float64, int) {
+	if outMACD == nil {
+		outMACD = make([]float64, len(real))
+	}
+	if outMACDSignal == nil {
+		outMACDSignal = make([]float64, len(real))
+	}
+	if outMACDHist == nil {
+		outMACDHist = make([]float64, len(real))
+	}
+	out, _, _, begIdx := Macd(real, fastPeriod, slowPeriod, signalPeriod, outMACD, outMACDSignal, outMACDHist)
+	return alignFloat(outMACD, len(real), begIdx, len(out)), alignFloat(outMACDSignal, len(real), begIdx, len(out)), alignFloat(outMACDHist, len(real), begIdx, len(out)), begIdx
+}
+
+// MacdExtAligned is the same as MacdExt, but the outputs are aligned with the input. See the package documentation.
+func MacdExtAligned(real []float64, fastPeriod, fastMAType, slowPeriod, slowMAType, signalPeriod, signalMAType int, outMACD []float64, outMACDSignal []float64, outMACDHist []float64) ([]float64, []float64, []float64, int) {
+	if outMACD == nil {
+		outMACD = make([]float64, len(real))
+	}
+	if outMACDSignal == nil {
+		outMACDSignal = make([]float64, len(real))
+	}
+	if outMACDHist == nil {
+		outMACDHist = make([]float64, len(real))
+	}
+	out, _, _, begIdx := MacdExt(real, fastPeriod, fastMAType, slowPeriod, slowMAType, signalPeriod, signalMAType, outMACD, outMACDSignal, outMACDHist)
+	return alignFloat(outMACD, len(real), begIdx, len(out)), alignFloat(outMACDSignal, len(real), begIdx, len(out)), alignFloat(outMACDHist, len(real), begIdx, len(out)), begIdx
+}
+
+// MacdFixAligned is the same as MacdFix, but the outputs are aligned with the input. See the package documentation.
+func MacdFixAligned(real []float64, signalPeriod int, outMACD []float64, outMACDSignal []float64, outMACDHist []float64) ([]float64, []float64, []float64, int) {
+	if outMACD == nil {
+		outMACD = make([]float64, len(real))
+	}
+	if outMACDSignal == nil {
+		outMACDSignal = make([]float64, len(real))
+	}
+	if outMACDHist == nil {
+		outMACDHist = make([]float64, len(real))
+	}
+	out, _, _, begIdx := MacdFix(real, signalPeriod, outMACD, outMACDSignal, outMACDHist)
+	return alignFloat(outMACD, len(real), begIdx, len(out)), alignFloat(outMACDSignal, len(real), begIdx, len(out)), alignFloat(outMACDHist, len(real), begIdx, len(out)), begIdx
+}
+
+// MamaAligned is the same as Mama, but the outputs are aligned with the input. See the package documentation.
+func MamaAligned(real []float64, fastLimit, slowLimit float64, outMAMA []float64, outFAMA []float64) ([]float64, []float64, int) {
+	if outMAMA == nil {
+		outMAMA = make([]float64, len(real))
+	}
+	if outFAMA == nil {
+		outFAMA = make([]float64, len(real))
+	}
+	out, _, begIdx := Mama(real, fastLimit, slowLimit, outMAMA, outFAMA)
+	return alignFloat(outMAMA, len(real), begIdx, len(out)), alignFloat(outFAMA, len(real), begIdx, len(out)), begIdx
+}
+
+// MavpAligned is the same as Mavp, but the outputs are aligned with the input. See the package documentation.
+func MavpAligned(real, periods []float64, minPeriod, maxPeriod, mAType int, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(real))
+	}
+	out, begIdx := Mavp(real, periods, minPeriod, maxPeriod, mAType, outReal)
+	return alignFloat(outReal, len(real), begIdx, len(out)), begIdx
+}
+
+// MaxAligned is the same as Max, but the outputs are aligned with the input. See the package documentation.
+func MaxAligned(real []float64, timePeriod int, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(real))
+	}
+	out, begIdx := Max(real, timePeriod, outReal)
+	return alignFloat(outReal, len(real), begIdx, len(out)), begIdx
+}
+
+// MaxIndexAligned is the same as MaxIndex, but the outputs are aligned with the input. See the package documentation.
+func MaxIndexAligned(real []float64, timePeriod int, outInteger []int) ([]int, int) {
+	if outInteger == nil {
+		outInteger = make([]int, len(real))
+	}
+	out, begIdx := MaxIndex(real, timePeriod, outInteger)
+	return alignInt(outInteger, len(real), begIdx, len(out)), begIdx
+}
+
+// MedPriceAligned is the same as MedPrice, but the outputs are aligned with the input. See the package documentation.
+func MedPriceAligned(high, low []float64, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(high))
+	}
+	out, begIdx := MedPrice(high, low, outReal)
+	return alignFloat(outReal, len(high), begIdx, len(out)), begIdx
+}
+
+// MfiAligned is the same as Mfi, but the outputs are aligned with the input. See the package documentation.
+func MfiAligned(high, low, close, volume []float64, timePeriod int, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(high))
+	}
+	out, begIdx := Mfi(high, low, close, volume, timePeriod, outReal)
+	return alignFloat(outReal, len(high), begIdx, len(out)), begIdx
+}
+
+// MidPointAligned is the same as MidPoint, but the outputs are aligned with the input. See the package documentation.
+func MidPointAligned(real []float64, timePeriod int, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(real))
+	}
+	out, begIdx := MidPoint(real, timePeriod, outReal)
+	return alignFloat(outReal, len(real), begIdx, len(out)), begIdx
+}
+
+// MidPriceAligned is the same as MidPrice, but the outputs are aligned with the input. See the package documentation.
+func MidPriceAligned(high, low []float64, timePeriod int, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(high))
+	}
+	out, begIdx := MidPrice(high, low, timePeriod, outReal)
+	return alignFloat(outReal, len(high), begIdx, len(out)), begIdx
+}
+
+// MinAligned is the same as Min, but the outputs are aligned with the input. See the package documentation.
+func MinAligned(real []float64, timePeriod int, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(real))
+	}
+	out, begIdx := Min(real, timePeriod, outReal)
+	return alignFloat(outReal, len(real), begIdx, len(out)), begIdx
+}
+
+// MinIndexAligned is the same as MinIndex, but the outputs are aligned with the input. See the package documentation.
+func MinIndexAligned(real []float64, timePeriod int, outInteger []int) ([]int, int) {
+	if outInteger == nil {
+		outInteger = make([]int, len(real))
+	}
+	out, begIdx := MinIndex(real, timePeriod, outInteger)
+	return alignInt(outInteger, len(real), begIdx, len(out)), begIdx
+}
+
+// MinMaxAligned is the same as MinMax, but the outputs are aligned with the input. See the package documentation.
+func MinMaxAligned(real []float64, timePeriod int, outMin []float64, outMax []float64) ([]float64, []float64, int) {
+	if outMin == nil {
+		outMin = make([]float64, len(real))
+	}
+	if outMax == nil {
+		outMax = make([]float64, len(real))
+	}
+	out, _, begIdx := MinMax(real, timePeriod, outMin, outMax)
+	return alignFloat(outMin, len(real), begIdx, len(out)), alignFloat(outMax, len(real), begIdx, len(out)), begIdx
+}
+
+// MinMaxIndexAligned is the same as MinMaxIndex, but the outputs are aligned with the input. See the package documentation.
+func MinMaxIndexAligned(real []float64, timePeriod int, outMinIdx []int, outMaxIdx []int) ([]int, []int, int) {
+	if outMinIdx == nil {
+		outMinIdx = make([]int, len(real))
+	}
+	if outMaxIdx == nil {
+		outMaxIdx = make([]int, len(real))
+	}
+	out, _, begIdx := MinMaxIndex(real, timePeriod, outMinIdx, outMaxIdx)
+	return alignInt(outMinIdx, len(real), begIdx, len(out)), alignInt(outMaxIdx, len(real), begIdx, len(out)), begIdx
+}
+
+// MinusDiAligned is the same as MinusDi, but the outputs are aligned with the input. See the package documentation.
+func MinusDiAligned(high, low, close []float64, timePeriod int, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(high))
+	}
+	out, begIdx := MinusDi(high, low, close, timePeriod, outReal)
+	return alignFloat(outReal, len(high), begIdx, len(out)), begIdx
+}
+
+// MinusDmAligned is the same as MinusDm, but the outputs are aligned with the input. See the package documentation.
+func MinusDmAligned(high, low []float64, timePeriod int, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(high))
+	}
+	out, begIdx := MinusDm(high, low, timePeriod, outReal)
+	return alignFloat(outReal, len(high), begIdx, len(out)), begIdx
+}
+
+// MomAligned is the same as Mom, but the outputs are aligned with the input. See the package documentation.
+func MomAligned(real []float64, timePeriod int, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(real))
+	}
+	out, begIdx := Mom(real, timePeriod, outReal)
+	return alignFloat(outReal, len(real), begIdx, len(out)), begIdx
+}
+
+// MultAligned is the same as Mult, but the outputs are aligned with the input. See the package documentation.
+func MultAligned(real0, real1 []float64, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(real0))
+	}
+	out, begIdx := Mult(real0, real1, outReal)
+	return alignFloat(outReal, len(real0), begIdx, len(out)), begIdx
+}
+
+// NatrAligned is the same as Natr, but the outputs are aligned with the input. See the package documentation.
+func NatrAligned(high, low, close []float64, timePeriod int, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(high))
+	}
+	out, begIdx := Natr(high, low, close, timePeriod, outReal)
+	return alignFloat(outReal, len(high), begIdx, len(out)), begIdx
+}
+
+// ObvAligned is the same as Obv, but the outputs are aligned with the input. See the package documentation.
+func ObvAligned(real, volume []float64, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(real))
+	}
+	out, begIdx := Obv(real, volume, outReal)
+	return alignFloat(outReal, len(real), begIdx, len(out)), begIdx
+}
+
+// PlusDiAligned is the same as PlusDi, but the outputs are aligned with the input. See the package documentation.
+func PlusDiAligned(high, low, close []float64, timePeriod int, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(high))
+	}
+	out, begIdx := PlusDi(high, low, close, timePeriod, outReal)
+	return alignFloat(outReal, len(high), begIdx, len(out)), begIdx
+}
+
+// PlusDmAligned is the same as PlusDm, but the outputs are aligned with the input. See the package documentation.
+func PlusDmAligned(high, low []float64, timePeriod int, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(high))
+	}
+	out, begIdx := PlusDm(high, low, timePeriod, outReal)
+	return alignFloat(outReal, len(high), begIdx, len(out)), begIdx
+}
+
+// PpoAligned is the same as Ppo, but the outputs are aligned with the input. See the package documentation.
+func PpoAligned(real []float64, fastPeriod, slowPeriod, mAType int, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(real))
+	}
+	out, begIdx := Ppo(real, fastPeriod, slowPeriod, mAType, outReal)
+	return alignFloat(outReal, len(real), begIdx, len(out)), begIdx
+}
+
+// RocAligned is the same as Roc, but the outputs are aligned with the input. See the package documentation.
+func RocAligned(real []float64, timePeriod int, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(real))
+	}
+	out, begIdx := Roc(real, timePeriod, outReal)
+	return alignFloat(outReal, len(real), begIdx, len(out)), begIdx
+}
+
+// RocpAligned is the same as Rocp, but the outputs are aligned with the input. See the package documentation.
+func RocpAligned(real []float64, timePeriod int, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(real))
+	}
+	out, begIdx := Rocp(real, timePeriod, outReal)
+	return alignFloat(outReal, len(real), begIdx, len(out)), begIdx
+}
+
+// RocrAligned is the same as Rocr, but the outputs are aligned with the input. See the package documentation.
+func RocrAligned(real []float64, timePeriod int, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(real))
+	}
+	out, begIdx := Rocr(real, timePeriod, outReal)
+	return alignFloat(outReal, len(real), begIdx, len(out)), begIdx
+}
+
+// Rocr100Aligned is the same as Rocr100, but the outputs are aligned with the input. See the package documentation.
+func Rocr100Aligned(real []float64, timePeriod int, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(real))
+	}
+	out, begIdx := Rocr100(real, timePeriod, outReal)
+	return alignFloat(outReal, len(real), begIdx, len(out)), begIdx
+}
+
+// RsiAligned is the same as Rsi, but the outputs are aligned with the input. See the package documentation.
+func RsiAligned(real []float64, timePeriod int, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(real))
+	}
+	out, begIdx := Rsi(real, timePeriod, outReal)
+	return alignFloat(outReal, len(real), begIdx, len(out)), begIdx
+}
+
+// SarAligned is the same as Sar, but the outputs are aligned with the input. See the package documentation.
+func SarAligned(high, low []float64, acceleration, maximum float64, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(high))
+	}
+	out, begIdx := Sar(high, low, acceleration, maximum, outReal)
+	return alignFloat(outReal, len(high), begIdx, len(out)), begIdx
+}
+
+// SarExtAligned is the same as SarExt, but the outputs are aligned with the input. See the package documentation.
+func SarExtAligned(high, low []float64, startValue, offsetOnReverse, accelerationInitLong, accelerationLong, accelerationMaxLong, accelerationInitShort, accelerationShort, accelerationMaxShort float64, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(high))
+	}
+	out, begIdx := SarExt(high, low, startValue, offsetOnReverse, accelerationInitLong, accelerationLong, accelerationMaxLong, accelerationInitShort, accelerationShort, accelerationMaxShort, outReal)
+	return alignFloat(outReal, len(high), begIdx, len(out)), begIdx
+}
+
+// SinAligned is the same as Sin, but the outputs are aligned with the input. See the package documentation.
+func SinAligned(real []float64, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(real))
+	}
+	out, begIdx := Sin(real, outReal)
+	return alignFloat(outReal, len(real), begIdx, len(out)), begIdx
+}
+
+// SinhAligned is the same as Sinh, but the outputs are aligned with the input. See the package documentation.
+func SinhAligned(real []float64, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(real))
+	}
+	out, begIdx := Sinh(real, outReal)
+	return alignFloat(outReal, len(real), begIdx, len(out)), begIdx
+}
+
+// SmaAligned is the same as Sma, but the outputs are aligned with the input. See the package documentation.
+func SmaAligned(real []float64, timePeriod int, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(real))
+	}
+	out, begIdx := Sma(real, timePeriod, outReal)
+	return alignFloat(outReal, len(real), begIdx, len(out)), begIdx
+}
+
+// SqrtAligned is the same as Sqrt, but the outputs are aligned with the input. See the package documentation.
+func SqrtAligned(real []float64, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(real))
+	}
+	out, begIdx := Sqrt(real, outReal)
+	return alignFloat(outReal, len(real), begIdx, len(out)), begIdx
+}
+
+// StdDevAligned is the same as StdDev, but the outputs are aligned with the input. See the package documentation.
+func StdDevAligned(real []float64, timePeriod int, nbDev float64, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(real))
+	}
+	out, begIdx := StdDev(real, timePeriod, nbDev, outReal)
+	return alignFloat(outReal, len(real), begIdx, len(out)), begIdx
+}
+
+// StochAligned is the same as Stoch, but the outputs are aligned with the input. See the package documentation.
+func StochAligned(high, low, close []float64, fastKPeriod, slowKPeriod, slowKMAType, slowDPeriod, slowDMAType int, outSlowK []float64, outSlowD []float64) ([]float64, []float64, int) {
+	if outSlowK == nil {
+		outSlowK = make([]float64, len(high))
+	}
+	if outSlowD == nil {
+		outSlowD = make([]float64, len(high))
+	}
+	out, _, begIdx := Stoch(high, low, close, fastKPeriod, slowKPeriod, slowKMAType, slowDPeriod, slowDMAType, outSlowK, outSlowD)
+	return alignFloat(outSlowK, len(high), begIdx, len(out)), alignFloat(outSlowD, len(high), begIdx, len(out)), begIdx
+}
+
+// StochfAligned is the same as Stochf, but the outputs are aligned with the input. See the package documentation.
+func StochfAligned(high, low, close []float64, fastKPeriod, fastDPeriod, fastDMAType int, outFastK []float64, outFastD []float64) ([]float64, []float64, int) {
+	if outFastK == nil {
+		outFastK = make([]float64, len(high))
+	}
+	if outFastD == nil {
+		outFastD = make([]float64, len(high))
+	}
+	out, _, begIdx := Stochf(high, low, close, fastKPeriod, fastDPeriod, fastDMAType, outFastK, outFastD)
+	return alignFloat(outFastK, len(high), begIdx, len(out)), alignFloat(outFastD, len(high), begIdx, len(out)), begIdx
+}
+
+// StochRsiAligned is the same as StochRsi, but the outputs are aligned with the input. See the package documentation.
+func StochRsiAligned(real []float64, timePeriod, fastKPeriod, fastDPeriod, fastDMAType int, outFastK []float64, outFastD []float64) ([]float64, []float64, int) {
+	if outFastK == nil {
+		outFastK = make([]float64, len(real))
+	}
+	if outFastD == nil {
+		outFastD = make([]float64, len(real))
+	}
+	out, _, begIdx := StochRsi(real, timePeriod, fastKPeriod, fastDPeriod, fastDMAType, outFastK, outFastD)
+	return alignFloat(outFastK, len(real), begIdx, len(out)), alignFloat(outFastD, len(real), begIdx, len(out)), begIdx
+}
+
+// SubAligned is the same as Sub, but the outputs are aligned with the input. See the package documentation.
+func SubAligned(real0, real1 []float64, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(real0))
+	}
+	out, begIdx := Sub(real0, real1, outReal)
+	return alignFloat(outReal, len(real0), begIdx, len(out)), begIdx
+}
+
+// SumAligned is the same as Sum, but the outputs are aligned with the input. See the package documentation.
+func SumAligned(real []float64, timePeriod int, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(real))
+	}
+	out, begIdx := Sum(real, timePeriod, outReal)
+	return alignFloat(outReal, len(real), begIdx, len(out)), begIdx
+}
+
+// T3Aligned is the same as T3, but the outputs are aligned with the input. See the package documentation.
+func T3Aligned(real []float64, timePeriod int, vFactor float64, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(real))
+	}
+	out, begIdx := T3(real, timePeriod, vFactor, outReal)
+	return alignFloat(outReal, len(real), begIdx, len(out)), begIdx
+}
+
+// TanAligned is the same as Tan, but the outputs are aligned with the input. See the package documentation.
+func TanAligned(real []float64, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(real))
+	}
+	out, begIdx := Tan(real, outReal)
+	return alignFloat(outReal, len(real), begIdx, len(out)), begIdx
+}
+
+// TanhAligned is the same as Tanh, but the outputs are aligned with the input. See the package documentation.
+func TanhAligned(real []float64, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(real))
+	}
+	out, begIdx := Tanh(real, outReal)
+	return alignFloat(outReal, len(real), begIdx, len(out)), begIdx
+}
+
+// TemaAligned is the same as Tema, but the outputs are aligned with the input. See the package documentation.
+func TemaAligned(real []float64, timePeriod int, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(real))
+	}
+	out, begIdx := Tema(real, timePeriod, outReal)
+	return alignFloat(outReal, len(real), begIdx, len(out)), begIdx
+}
+
+// TrangeAligned is the same as Trange, but the outputs are aligned with the input. See the package documentation.
+func TrangeAligned(high, low, close []float64, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(high))
+	}
+	out, begIdx := Trange(high, low, close, outReal)
+	return alignFloat(outReal, len(high), begIdx, len(out)), begIdx
+}
+
+// TriMaAligned is the same as TriMa, but the outputs are aligned with the input. See the package documentation.
+func TriMaAligned(real []float64, timePeriod int, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(real))
+	}
+	out, begIdx := TriMa(real, timePeriod, outReal)
+	return alignFloat(outReal, len(real), begIdx, len(out)), begIdx
+}
+
+// TrixAligned is the same as Trix, but the outputs are aligned with the input. See the package documentation.
+func TrixAligned(real []float64, timePeriod int, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(real))
+	}
+	out, begIdx := Trix(real, timePeriod, outReal)
+	return alignFloat(outReal, len(real), begIdx, len(out)), begIdx
+}
+
+// TsfAligned is the same as Tsf, but the outputs are aligned with the input. See the package documentation.
+func TsfAligned(real []float64, timePeriod int, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(real))
+	}
+	out, begIdx := Tsf(real, timePeriod, outReal)
+	return alignFloat(outReal, len(real), begIdx, len(out)), begIdx
+}
+
+// TypPriceAligned is the same as TypPrice, but the outputs are aligned with the input. See the package documentation.
+func TypPriceAligned(high, low, close []float64, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(high))
+	}
+	out, begIdx := TypPrice(high, low, close, outReal)
+	return alignFloat(outReal, len(high), begIdx, len(out)), begIdx
+}
+
+// UltOscAligned is the same as UltOsc, but the outputs are aligned with the input. See the package documentation.
+func UltOscAligned(high, low, close []float64, timePeriod1, timePeriod2, timePeriod3 int, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(high))
+	}
+	out, begIdx := UltOsc(high, low, close, timePeriod1, timePeriod2, timePeriod3, outReal)
+	return alignFloat(outReal, len(high), begIdx, len(out)), begIdx
+}
+
+// VarAligned is the same as Var, but the outputs are aligned with the input. See the package documentation.
+func VarAligned(real []float64, timePeriod int, nbDev float64, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(real))
+	}
+	out, begIdx := Var(real, timePeriod, nbDev, outReal)
+	return alignFloat(outReal, len(real), begIdx, len(out)), begIdx
+}
+
+// WclPriceAligned is the same as WclPrice, but the outputs are aligned with the input. See the package documentation.
+func WclPriceAligned(high, low, close []float64, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(high))
+	}
+	out, begIdx := WclPrice(high, low, close, outReal)
+	return alignFloat(outReal, len(high), begIdx, len(out)), begIdx
+}
+
+// WillrAligned is the same as Willr, but the outputs are aligned with the input. See the package documentation.
+func WillrAligned(high, low, close []float64, timePeriod int, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(high))
+	}
+	out, begIdx := Willr(high, low, close, timePeriod, outReal)
+	return alignFloat(outReal, len(high), begIdx, len(out)), begIdx
+}
+
+// WmaAligned is the same as Wma, but the outputs are aligned with the input. See the package documentation.
+func WmaAligned(real []float64, timePeriod int, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(real))
+	}
+	out, begIdx := Wma(real, timePeriod, outReal)
+	return alignFloat(outReal, len(real), begIdx, len(out)), begIdx
+}
@@ -0,0 +1,1857 @@
+package talib
+
+import "math"
+
+var functions = []*FuncInfo{
+	{
+		Name:        "ACOS",
+		GoName:      "Acos",
+		Description: "Vector Trigonometric ACos",
+		Inputs:      []string{"real"},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: Acos,
+	},
+	{
+		Name:        "AD",
+		GoName:      "Ad",
+		Description: "Chaikin A/D Line",
+		Inputs:      []string{"high", "low", "close", "volume"},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: Ad,
+	},
+	{
+		Name:        "ADD",
+		GoName:      "Add",
+		Description: "Vector Arithmetic Add",
+		Inputs:      []string{"real0", "real1"},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: Add,
+	},
+	{
+		Name:        "ADOSC",
+		GoName:      "AdOsc",
+		Description: "Chaikin A/D Oscillator",
+		Inputs:      []string{"high", "low", "close", "volume"},
+		Options: []OptionInfo{
+			{Name: "fastPeriod", Type: OptionInteger, Min: 2, Max: 100000, Description: "Number of period for the fast MA"},
+			{Name: "slowPeriod", Type: OptionInteger, Min: 2, Max: 100000, Description: "Number of period for the slow MA"},
+		},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: AdOsc,
+	},
+	{
+		Name:        "ADX",
+		GoName:      "Adx",
+		Description: "Average Directional Movement Index",
+		Inputs:      []string{"high", "low", "close"},
+		Options: []OptionInfo{
+			{Name: "timePeriod", Type: OptionInteger, Min: 2, Max: 100000, Description: "Number of period"},
+		},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: Adx,
+	},
+	{
+		Name:        "ADXR",
+		GoName:      "Adxr",
+		Description: "Average Directional Movement Index Rating",
+		Inputs:      []string{"high", "low", "close"},
+		Options: []OptionInfo{
+			{Name: "timePeriod", Type: OptionInteger, Min: 2, Max: 100000, Description: "Number of period"},
+		},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: Adxr,
+	},
+	{
+		Name:        "APO",
+		GoName:      "Apo",
+		Description: "Absolute Price Oscillator",
+		Inputs:      []string{"real"},
+		Options: []OptionInfo{
+			{Name: "fastPeriod", Type: OptionInteger, Min: 2, Max: 100000, Description: "Number of period for the fast MA"},
+			{Name: "slowPeriod", Type: OptionInteger, Min: 2, Max: 100000, Description: "Number of period for the slow MA"},
+			{Name: "mAType", Type: OptionMAType, Min: 0, Max: MAType_T3, Description: "Type of Moving Average"},
+		},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: Apo,
+	},
+	{
+		Name:        "AROON",
+		GoName:      "AroOn",
+		Description: "Aroon",
+		Inputs:      []string{"high", "low"},
+		Options: []OptionInfo{
+			{Name: "timePeriod", Type: OptionInteger, Min: 2, Max: 100000, Description: "Number of period"},
+		},
+		Outputs: []OutputInfo{
+			{Name: "aroonDown"},
+			{Name: "aroonUp"},
+		},
+		fn: AroOn,
+	},
+	{
+		Name:        "AROONOSC",
+		GoName:      "AroOnOsc",
+		Description: "Aroon Oscillator",
+		Inputs:      []string{"high", "low"},
+		Options: []OptionInfo{
+			{Name: "timePeriod", Type: OptionInteger, Min: 2, Max: 100000, Description: "Number of period"},
+		},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: AroOnOsc,
+	},
+	{
+		Name:        "ASIN",
+		GoName:      "Asin",
+		Description: "Vector Trigonometric ASin",
+		Inputs:      []string{"real"},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: Asin,
+	},
+	{
+		Name:        "ATAN",
+		GoName:      "Atan",
+		Description: "Vector Trigonometric ATan",
+		Inputs:      []string{"real"},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: Atan,
+	},
+	{
+		Name:        "ATR",
+		GoName:      "Atr",
+		Description: "Average True Range",
+		Inputs:      []string{"high", "low", "close"},
+		Options: []OptionInfo{
+			{Name: "timePeriod", Type: OptionInteger, Min: 1, Max: 100000, Description: "Number of period"},
+		},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: Atr,
+	},
+	{
+		Name:        "AVGPRICE",
+		GoName:      "AvgPrice",
+		Description: "Average Price",
+		Inputs:      []string{"open", "high", "low", "close"},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: AvgPrice,
+	},
+	{
+		Name:        "BBANDS",
+		GoName:      "BBands",
+		Description: "Bollinger Bands",
+		Inputs:      []string{"real"},
+		Options: []OptionInfo{
+			{Name: "timePeriod", Type: OptionInteger, Min: 2, Max: 100000, Description: "Number of period"},
+			{Name: "nbDevUp", Type: OptionReal, Min: -math.MaxFloat64, Max: math.MaxFloat64, Description: "Deviation multiplier for upper band"},
+			{Name: "nbDevDn", Type: OptionReal, Min: -math.MaxFloat64, Max: math.MaxFloat64, Description: "Deviation multiplier for lower band"},
+			{Name: "mAType", Type: OptionMAType, Min: 0, Max: MAType_T3, Description: "Type of Moving Average"},
+		},
+		Outputs: []OutputInfo{
+			{Name: "upperBand"},
+			{Name: "middleBand"},
+			{Name: "lowerBand"},
+		},
+		fn: BBands,
+	},
+	{
+		Name:        "BETA",
+		GoName:      "Beta",
+		Description: "Beta",
+		Inputs:      []string{"real0", "real1"},
+		Options: []OptionInfo{
+			{Name: "timePeriod", Type: OptionInteger, Min: 1, Max: 100000, Description: "Number of period"},
+		},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: Beta,
+	},
+	{
+		Name:        "BOP",
+		GoName:      "Bop",
+		Description: "Balance Of Power",
+		Inputs:      []string{"open", "high", "low", "close"},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: Bop,
+	},
+	{
+		Name:        "CCI",
+		GoName:      "Cci",
+		Description: "Commodity Channel Index",
+		Inputs:      []string{"high", "low", "close"},
+		Options: []OptionInfo{
+			{Name: "timePeriod", Type: OptionInteger, Min: 2, Max: 100000, Description: "Number of period"},
+		},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: Cci,
+	},
+	{
+		Name:        "CDL2CROWS",
+		GoName:      "Cdl2Crows",
+		Description: "Two Crows",
+		Inputs:      []string{"open", "high", "low", "close"},
+		Outputs: []OutputInfo{
+			{Name: "integer", Integer: true},
+		},
+		fn: Cdl2Crows,
+	},
+	{
+		Name:        "CDL3BLACKCROWS",
+		GoName:      "Cdl3BlackCrows",
+		Description: "Three Black Crows",
+		Inputs:      []string{"open", "high", "low", "close"},
+		Outputs: []OutputInfo{
+			{Name: "integer", Integer: true},
+		},
+		fn: Cdl3BlackCrows,
+	},
+	{
+		Name:        "CDL3INSIDE",
+		GoName:      "Cdl3Inside",
+		Description: "Three Inside Up/Down",
+		Inputs:      []string{"open", "high", "low", "close"},
+		Outputs: []OutputInfo{
+			{Name: "integer", Integer: true},
+		},
+		fn: Cdl3Inside,
+	},
+	{
+		Name:        "CDL3LINESTRIKE",
+		GoName:      "Cdl3LineStrike",
+		Description: "Three-Line Strike",
+		Inputs:      []string{"open", "high", "low", "close"},
+		Outputs: []OutputInfo{
+			{Name: "integer", Integer: true},
+		},
+		fn: Cdl3LineStrike,
+	},
+	{
+		Name:        "CDL3OUTSIDE",
+		GoName:      "Cdl3Outside",
+		Description: "Three Outside Up/Down",
+		Inputs:      []string{"open", "high", "low", "close"},
+		Outputs: []OutputInfo{
+			{Name: "integer", Integer: true},
+		},
+		fn: Cdl3Outside,
+	},
+	{
+		Name:        "CDL3STARSINSOUTH",
+		GoName:      "Cdl3StarsinSouth",
+		Description: "Three Stars In The South",
+		Inputs:      []string{"open", "high", "low", "close"},
+		Outputs: []OutputInfo{
+			{Name: "integer", Integer: true},
+		},
+		fn: Cdl3StarsinSouth,
+	},
+	{
+		Name:        "CDL3WHITESOLDIERS",
+		GoName:      "Cdl3WhiteSoldiers",
+		Description: "Three Advancing White Soldiers",
+		Inputs:      []string{"open", "high", "low", "close"},
+		Outputs: []OutputInfo{
+			{Name: "integer", Integer: true},
+		},
+		fn: Cdl3WhiteSoldiers,
+	},
+	{
+		Name:        "CDLABANDONEDBABY",
+		GoName:      "CdlAbandonedBaby",
+		Description: "Abandoned Baby",
+		Inputs:      []string{"open", "high", "low", "close"},
+		Options: []OptionInfo{
+			{Name: "penetration", Type: OptionReal, Min: 0, Max: math.MaxFloat64, Description: "Percentage of penetration of a candle within another candle"},
+		},
+		Outputs: []OutputInfo{
+			{Name: "integer", Integer: true},
+		},
+		fn: CdlAbandonedBaby,
+	},
+	{
+		Name:        "CDLADVANCEBLOCK",
+		GoName:      "CdlAdvanceBlock",
+		Description: "Advance Block",
+		Inputs:      []string{"open", "high", "low", "close"},
+		Outputs: []OutputInfo{
+			{Name: "integer", Integer: true},
+		},
+		fn: CdlAdvanceBlock,
+	},
+	{
+		Name:        "CDLBELTHOLD",
+		GoName:      "CdlBelthold",
+		Description: "Belt-hold",
+		Inputs:      []string{"open", "high", "low", "close"},
+		Outputs: []OutputInfo{
+			{Name: "integer", Integer: true},
+		},
+		fn: CdlBelthold,
+	},
+	{
+		Name:        "CDLBREAKAWAY",
+		GoName:      "CdlBreakaway",
+		Description: "Breakaway",
+		Inputs:      []string{"open", "high", "low", "close"},
+		Outputs: []OutputInfo{
+			{Name: "integer", Integer: true},
+		},
+		fn: CdlBreakaway,
+	},
+	{
+		Name:        "CDLCLOSINGMARUBOZU",
+		GoName:      "CdlClosingMarubozu",
+		Description: "Closing Marubozu",
+		Inputs:      []string{"open", "high", "low", "close"},
+		Outputs: []OutputInfo{
+			{Name: "integer", Integer: true},
+		},
+		fn: CdlClosingMarubozu,
+	},
+	{
+		Name:        "CDLCONCEALBABYSWALL",
+		GoName:      "CdlConcealBabySwall",
+		Description: "Concealing Baby Swallow",
+		Inputs:      []string{"open", "high", "low", "close"},
+		Outputs: []OutputInfo{
+			{Name: "integer", Integer: true},
+		},
+		fn: CdlConcealBabySwall,
+	},
+	{
+		Name:        "CDLCOUNTERATTACK",
+		GoName:      "CdlCounterattack",
+		Description: "Counterattack",
+		Inputs:      []string{"open", "high", "low", "close"},
+		Outputs: []OutputInfo{
+			{Name: "integer", Integer: true},
+		},
+		fn: CdlCounterattack,
+	},
+	{
+		Name:        "CDLDARKCLOUDCOVER",
+		GoName:      "CdlDarkCloudCover",
+		Description: "Dark Cloud Cover",
+		Inputs:      []string{"open", "high", "low", "close"},
+		Options: []OptionInfo{
+			{Name: "penetration", Type: OptionReal, Min: 0, Max: math.MaxFloat64, Description: "Percentage of penetration of a candle within another candle"},
+		},
+		Outputs: []OutputInfo{
+			{Name: "integer", Integer: true},
+		},
+		fn: CdlDarkCloudCover,
+	},
+	{
+		Name:        "CDLDOJI",
+		GoName:      "CdlDoji",
+		Description: "Doji",
+		Inputs:      []string{"open", "high", "low", "close"},
+		Outputs: []OutputInfo{
+			{Name: "integer", Integer: true},
+		},
+		fn: CdlDoji,
+	},
+	{
+		Name:        "CDLDOJISTAR",
+		GoName:      "CdlDojiStar",
+		Description: "Doji Star",
+		Inputs:      []string{"open", "high", "low", "close"},
+		Outputs: []OutputInfo{
+			{Name: "integer", Integer: true},
+		},
+		fn: CdlDojiStar,
+	},
+	{
+		Name:        "CDLDRAGONFLYDOJI",
+		GoName:      "CdlDragonflyDoji",
+		Description: "Dragonfly Doji",
+		Inputs:      []string{"open", "high", "low", "close"},
+		Outputs: []OutputInfo{
+			{Name: "integer", Integer: true},
+		},
+		fn: CdlDragonflyDoji,
+	},
+	{
+		Name:        "CDLENGULFING",
+		GoName:      "CdlEngulfing",
+		Description: "Engulfing Pattern",
+		Inputs:      []string{"open", "high", "low", "close"},
+		Outputs: []OutputInfo{
+			{Name: "integer", Integer: true},
+		},
+		fn: CdlEngulfing,
+	},
+	{
+		Name:        "CDLEVENINGDOJISTAR",
+		GoName:      "CdlEveningDojiStar",
+		Description: "Evening Doji Star",
+		Inputs:      []string{"open", "high", "low", "close"},
+		Options: []OptionInfo{
+			{Name: "penetration", Type: OptionReal, Min: 0, Max: math.MaxFloat64, Description: "Percentage of penetration of a candle within another candle"},
+		},
+		Outputs: []OutputInfo{
+			{Name: "integer", Integer: true},
+		},
+		fn: CdlEveningDojiStar,
+	},
+	{
+		Name:        "CDLEVENINGSTAR",
+		GoName:      "CdlEveningStar",
+		Description: "Evening Star",
+		Inputs:      []string{"open", "high", "low", "close"},
+		Options: []OptionInfo{
+			{Name: "penetration", Type: OptionReal, Min: 0, Max: math.MaxFloat64, Description: "Percentage of penetration of a candle within another candle"},
+		},
+		Outputs: []OutputInfo{
+			{Name: "integer", Integer: true},
+		},
+		fn: CdlEveningStar,
+	},
+	{
+		Name:        "CDLGAPSIDESIDEWHITE",
+		GoName:      "CdlGapSidesideWhite",
+		Description: "Up/Down-gap side-by-side white lines",
+		Inputs:      []string{"open", "high", "low", "close"},
+		Outputs: []OutputInfo{
+			{Name: "integer", Integer: true},
+		},
+		fn: CdlGapSidesideWhite,
+	},
+	{
+		Name:        "CDLGRAVESTONEDOJI",
+		GoName:      "CdlGravestoneDoji",
+		Description: "Gravestone Doji",
+		Inputs:      []string{"open", "high", "low", "close"},
+		Outputs: []OutputInfo{
+			{Name: "integer", Integer: true},
+		},
+		fn: CdlGravestoneDoji,
+	},
+	{
+		Name:        "CDLHAMMER",
+		GoName:      "CdlHammer",
+		Description: "Hammer",
+		Inputs:      []string{"open", "high", "low", "close"},
+		Outputs: []OutputInfo{
+			{Name: "integer", Integer: true},
+		},
+		fn: CdlHammer,
+	},
+	{
+		Name:        "CDLHANGINGMAN",
+		GoName:      "CdlHangingMan",
+		Description: "Hanging Man",
+		Inputs:      []string{"open", "high", "low", "close"},
+		Outputs: []OutputInfo{
+			{Name: "integer", Integer: true},
+		},
+		fn: CdlHangingMan,
+	},
+	{
+		Name:        "CDLHARAMI",
+		GoName:      "CdlHarami",
+		Description: "Harami Pattern",
+		Inputs:      []string{"open", "high", "low", "close"},
+		Outputs: []OutputInfo{
+			{Name: "integer", Integer: true},
+		},
+		fn: CdlHarami,
+	},
+	{
+		Name:        "CDLHARAMICROSS",
+		GoName:      "CdlHaramiCross",
+		Description: "Harami Cross Pattern",
+		Inputs:      []string{"open", "high", "low", "close"},
+		Outputs: []OutputInfo{
+			{Name: "integer", Integer: true},
+		},
+		fn: CdlHaramiCross,
+	},
+	{
+		Name:        "CDLHIGHWAVE",
+		GoName:      "CdlHighWave",
+		Description: "High-Wave Candle",
+		Inputs:      []string{"open", "high", "low", "close"},
+		Outputs: []OutputInfo{
+			{Name: "integer", Integer: true},
+		},
+		fn: CdlHighWave,
+	},
+	{
+		Name:        "CDLHIKKAKE",
+		GoName:      "CdlHikkake",
+		Description: "Hikkake Pattern",
+		Inputs:      []string{"open", "high", "low", "close"},
+		Outputs: []OutputInfo{
+			{Name: "integer", Integer: true},
+		},
+		fn: CdlHikkake,
+	},
+	{
+		Name:        "CDLHIKKAKEMOD",
+		GoName:      "CdlHikkakeMod",
+		Description: "Modified Hikkake Pattern",
+		Inputs:      []string{"open", "high", "low", "close"},
+		Outputs: []OutputInfo{
+			{Name: "integer", Integer: true},
+		},
+		fn: CdlHikkakeMod,
+	},
+	{
+		Name:        "CDLHOMINGPIGEON",
+		GoName:      "CdlHomingPigeon",
+		Description: "Homing Pigeon",
+		Inputs:      []string{"open", "high", "low", "close"},
+		Outputs: []OutputInfo{
+			{Name: "integer", Integer: true},
+		},
+		fn: CdlHomingPigeon,
+	},
+	{
+		Name:        "CDLIDENTICAL3CROWS",
+		GoName:      "CdlIdentical3Crows",
+		Description: "Identical Three Crows",
+		Inputs:      []string{"open", "high", "low", "close"},
+		Outputs: []OutputInfo{
+			{Name: "integer", Integer: true},
+		},
+		fn: CdlIdentical3Crows,
+	},
+	{
+		Name:        "CDLINNECK",
+		GoName:      "CdlInNeck",
+		Description: "In-Neck Pattern",
+		Inputs:      []string{"open", "high", "low", "close"},
+		Outputs: []OutputInfo{
+			{Name: "integer", Integer: true},
+		},
+		fn: CdlInNeck,
+	},
+	{
+		Name:        "CDLINVERTEDHAMMER",
+		GoName:      "CdlInvertedHammer",
+		Description: "Inverted Hammer",
+		Inputs:      []string{"open", "high", "low", "close"},
+		Outputs: []OutputInfo{
+			{Name: "integer", Integer: true},
+		},
+		fn: CdlInvertedHammer,
+	},
+	{
+		Name:        "CDLKICKING",
+		GoName:      "CdlKicking",
+		Description: "Kicking",
+		Inputs:      []string{"open", "high", "low", "close"},
+		Outputs: []OutputInfo{
+			{Name: "integer", Integer: true},
+		},
+		fn: CdlKicking,
+	},
+	{
+		Name:        "CDLKICKINGBYLENGTH",
+		GoName:      "CdlKickingByLength",
+		Description: "Kicking - bull/bear determined by the longer marubozu",
+		Inputs:      []string{"open", "high", "low", "close"},
+		Outputs: []OutputInfo{
+			{Name: "integer", Integer: true},
+		},
+		fn: CdlKickingByLength,
+	},
+	{
+		Name:        "CDLLADDERBOTTOM",
+		GoName:      "CdlLadderBottom",
+		Description: "Ladder Bottom",
+		Inputs:      []string{"open", "high", "low", "close"},
+		Outputs: []OutputInfo{
+			{Name: "integer", Integer: true},
+		},
+		fn: CdlLadderBottom,
+	},
+	{
+		Name:        "CDLLONGLEGGEDDOJI",
+		GoName:      "CdlLongLeggedDoji",
+		Description: "Long Legged Doji",
+		Inputs:      []string{"open", "high", "low", "close"},
+		Outputs: []OutputInfo{
+			{Name: "integer", Integer: true},
+		},
+		fn: CdlLongLeggedDoji,
+	},
+	{
+		Name:        "CDLLONGLINE",
+		GoName:      "CdlLongLine",
+		Description: "Long Line Candle",
+		Inputs:      []string{"open", "high", "low", "close"},
+		Outputs: []OutputInfo{
+			{Name: "integer", Integer: true},
+		},
+		fn: CdlLongLine,
+	},
+	{
+		Name:        "CDLMARUBOZU",
+		GoName:      "CdlMarubozu",
+		Description: "Marubozu",
+		Inputs:      []string{"open", "high", "low", "close"},
+		Outputs: []OutputInfo{
+			{Name: "integer", Integer: true},
+		},
+		fn: CdlMarubozu,
+	},
+	{
+		Name:        "CDLMATCHINGLOW",
+		GoName:      "CdlMatchingLow",
+		Description: "Matching Low",
+		Inputs:      []string{"open", "high", "low", "close"},
+		Outputs: []OutputInfo{
+			{Name: "integer", Integer: true},
+		},
+		fn: CdlMatchingLow,
+	},
+	{
+		Name:        "CDLMATHOLD",
+		GoName:      "CdlMatHold",
+		Description: "Mat Hold",
+		Inputs:      []string{"open", "high", "low", "close"},
+		Options: []OptionInfo{
+			{Name: "penetration", Type: OptionReal, Min: 0, Max: math.MaxFloat64, Description: "Percentage of penetration of a candle within another candle"},
+		},
+		Outputs: []OutputInfo{
+			{Name: "integer", Integer: true},
+		},
+		fn: CdlMatHold,
+	},
+	{
+		Name:        "CDLMORNINGDOJISTAR",
+		GoName:      "CdlMorningDojiStar",
+		Description: "Morning Doji Star",
+		Inputs:      []string{"open", "high", "low", "close"},
+		Options: []OptionInfo{
+			{Name: "penetration", Type: OptionReal, Min: 0, Max: math.MaxFloat64, Description: "Percentage of penetration of a candle within another candle"},
+		},
+		Outputs: []OutputInfo{
+			{Name: "integer", Integer: true},
+		},
+		fn: CdlMorningDojiStar,
+	},
+	{
+		Name:        "CDLMORNINGSTAR",
+		GoName:      "CdlMorningStar",
+		Description: "Morning Star",
+		Inputs:      []string{"open", "high", "low", "close"},
+		Options: []OptionInfo{
+			{Name: "penetration", Type: OptionReal, Min: 0, Max: math.MaxFloat64, Description: "Percentage of penetration of a candle within another candle"},
+		},
+		Outputs: []OutputInfo{
+			{Name: "integer", Integer: true},
+		},
+		fn: CdlMorningStar,
+	},
+	{
+		Name:        "CDLONNECK",
+		GoName:      "CdlOnNeck",
+		Description: "On-Neck Pattern",
+		Inputs:      []string{"open", "high", "low", "close"},
+		Outputs: []OutputInfo{
+			{Name: "integer", Integer: true},
+		},
+		fn: CdlOnNeck,
+	},
+	{
+		Name:        "CDLPIERCING",
+		GoName:      "CdlPiercing",
+		Description: "Piercing Pattern",
+		Inputs:      []string{"open", "high", "low", "close"},
+		Outputs: []OutputInfo{
+			{Name: "integer", Integer: true},
+		},
+		fn: CdlPiercing,
+	},
+	{
+		Name:        "CDLRICKSHAWMAN",
+		GoName:      "CdlRickshawMan",
+		Description: "Rickshaw Man",
+		Inputs:      []string{"open", "high", "low", "close"},
+		Outputs: []OutputInfo{
+			{Name: "integer", Integer: true},
+		},
+		fn: CdlRickshawMan,
+	},
+	{
+		Name:        "CDLRISEFALL3METHODS",
+		GoName:      "CdlRiseFall3Methods",
+		Description: "Rising/Falling Three Methods",
+		Inputs:      []string{"open", "high", "low", "close"},
+		Outputs: []OutputInfo{
+			{Name: "integer", Integer: true},
+		},
+		fn: CdlRiseFall3Methods,
+	},
+	{
+		Name:        "CDLSEPARATINGLINES",
+		GoName:      "CdlSeparatingLines",
+		Description: "Separating Lines",
+		Inputs:      []string{"open", "high", "low", "close"},
+		Outputs: []OutputInfo{
+			{Name: "integer", Integer: true},
+		},
+		fn: CdlSeparatingLines,
+	},
+	{
+		Name:        "CDLSHOOTINGSTAR",
+		GoName:      "CdlShootingStar",
+		Description: "Shooting Star",
+		Inputs:      []string{"open", "high", "low", "close"},
+		Outputs: []OutputInfo{
+			{Name: "integer", Integer: true},
+		},
+		fn: CdlShootingStar,
+	},
+	{
+		Name:        "CDLSHORTLINE",
+		GoName:      "CdlShortLine",
+		Description: "Short Line Candle",
+		Inputs:      []string{"open", "high", "low", "close"},
+		Outputs: []OutputInfo{
+			{Name: "integer", Integer: true},
+		},
+		fn: CdlShortLine,
+	},
+	{
+		Name:        "CDLSPINNINGTOP",
+		GoName:      "CdlSpinningTop",
+		Description: "Spinning Top",
+		Inputs:      []string{"open", "high", "low", "close"},
+		Outputs: []OutputInfo{
+			{Name: "integer", Integer: true},
+		},
+		fn: CdlSpinningTop,
+	},
+	{
+		Name:        "CDLSTALLEDPATTERN",
+		GoName:      "CdlStalledPattern",
+		Description: "Stalled Pattern",
+		Inputs:      []string{"open", "high", "low", "close"},
+		Outputs: []OutputInfo{
+			{Name: "integer", Integer: true},
+		},
+		fn: CdlStalledPattern,
+	},
+	{
+		Name:        "CDLSTICKSANDWICH",
+		GoName:      "CdlStickSandwich",
+		Description: "Stick Sandwich",
+		Inputs:      []string{"open", "high", "low", "close"},
+		Outputs: []OutputInfo{
+			{Name: "integer", Integer: true},
+		},
+		fn: CdlStickSandwich,
+	},
+	{
+		Name:        "CDLTAKURI",
+		GoName:      "CdlTakuri",
+		Description: "Takuri (Dragonfly Doji with very long lower shadow)",
+		Inputs:      []string{"open", "high", "low", "close"},
+		Outputs: []OutputInfo{
+			{Name: "integer", Integer: true},
+		},
+		fn: CdlTakuri,
+	},
+	{
+		Name:        "CDLTASUKIGAP",
+		GoName:      "CdlTasukiGap",
+		Description: "Tasuki Gap",
+		Inputs:      []string{"open", "high", "low", "close"},
+		Outputs: []OutputInfo{
+			{Name: "integer", Integer: true},
+		},
+		fn: CdlTasukiGap,
+	},
+	{
+		Name:        "CDLTHRUSTING",
+		GoName:      "CdlThrusting",
+		Description: "Thrusting Pattern",
+		Inputs:      []string{"open", "high", "low", "close"},
+		Outputs: []OutputInfo{
+			{Name: "integer", Integer: true},
+		},
+		fn: CdlThrusting,
+	},
+	{
+		Name:        "CDLTRISTAR",
+		GoName:      "CdlTristar",
+		Description: "Tristar Pattern",
+		Inputs:      []string{"open", "high", "low", "close"},
+		Outputs: []OutputInfo{
+			{Name: "integer", Integer: true},
+		},
+		fn: CdlTristar,
+	},
+	{
+		Name:        "CDLUNIQUE3RIVER",
+		GoName:      "CdlUnique3River",
+		Description: "Unique 3 River",
+		Inputs:      []string{"open", "high", "low", "close"},
+		Outputs: []OutputInfo{
+			{Name: "integer", Integer: true},
+		},
+		fn: CdlUnique3River,
+	},
+	{
+		Name:        "CDLUPSIDEGAP2CROWS",
+		GoName:      "CdlUpsideGap2Crows",
+		Description: "Upside Gap Two Crows",
+		Inputs:      []string{"open", "high", "low", "close"},
+		Outputs: []OutputInfo{
+			{Name: "integer", Integer: true},
+		},
+		fn: CdlUpsideGap2Crows,
+	},
+	{
+		Name:        "CDLXSIDEGAP3METHODS",
+		GoName:      "CdlxSideGap3Methods",
+		Description: "Upside/Downside Gap Three Methods",
+		Inputs:      []string{"open", "high", "low", "close"},
+		Outputs: []OutputInfo{
+			{Name: "integer", Integer: true},
+		},
+		fn: CdlxSideGap3Methods,
+	},
+	{
+		Name:        "CEIL",
+		GoName:      "Ceil",
+		Description: "Vector Ceil",
+		Inputs:      []string{"real"},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: Ceil,
+	},
+	{
+		Name:        "CMO",
+		GoName:      "Cmo",
+		Description: "Chande Momentum Oscillator",
+		Inputs:      []string{"real"},
+		Options: []OptionInfo{
+			{Name: "timePeriod", Type: OptionInteger, Min: 2, Max: 100000, Description: "Number of period"},
+		},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: Cmo,
+	},
+	{
+		Name:        "CORREL",
+		GoName:      "Correl",
+		Description: "Pearson's Correlation Coefficient (r)",
+		Inputs:      []string{"real0", "real1"},
+		Options: []OptionInfo{
+			{Name: "timePeriod", Type: OptionInteger, Min: 1, Max: 100000, Description: "Number of period"},
+		},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: Correl,
+	},
+	{
+		Name:        "COS",
+		GoName:      "Cos",
+		Description: "Vector Trigonometric Cos",
+		Inputs:      []string{"real"},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: Cos,
+	},
+	{
+		Name:        "COSH",
+		GoName:      "Cosh",
+		Description: "Vector Trigonometric Cosh",
+		Inputs:      []string{"real"},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: Cosh,
+	},
+	{
+		Name:        "DEMA",
+		GoName:      "Dema",
+		Description: "Double Exponential Moving Average",
+		Inputs:      []string{"real"},
+		Options: []OptionInfo{
+			{Name: "timePeriod", Type: OptionInteger, Min: 2, Max: 100000, Description: "Number of period"},
+		},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: Dema,
+	},
+	{
+		Name:        "DIV",
+		GoName:      "Div",
+		Description: "Vector Arithmetic Div",
+		Inputs:      []string{"real0", "real1"},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: Div,
+	},
+	{
+		Name:        "DX",
+		GoName:      "Dx",
+		Description: "Directional Movement Index",
+		Inputs:      []string{"high", "low", "close"},
+		Options: []OptionInfo{
+			{Name: "timePeriod", Type: OptionInteger, Min: 2, Max: 100000, Description: "Number of period"},
+		},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: Dx,
+	},
+	{
+		Name:        "EMA",
+		GoName:      "Ema",
+		Description: "Exponential Moving Average",
+		Inputs:      []string{"real"},
+		Options: []OptionInfo{
+			{Name: "timePeriod", Type: OptionInteger, Min: 2, Max: 100000, Description: "Number of period"},
+		},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: Ema,
+	},
+	{
+		Name:        "EXP",
+		GoName:      "Exp",
+		Description: "Vector Arithmetic Exp",
+		Inputs:      []string{"real"},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: Exp,
+	},
+	{
+		Name:        "FLOOR",
+		GoName:      "Floor",
+		Description: "Vector Floor",
+		Inputs:      []string{"real"},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: Floor,
+	},
+	{
+		Name:        "HT_DCPERIOD",
+		GoName:      "HtDcPeriod",
+		Description: "Hilbert Transform - Dominant Cycle Period",
+		Inputs:      []string{"real"},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: HtDcPeriod,
+	},
+	{
+		Name:        "HT_DCPHASE",
+		GoName:      "HtDcPhase",
+		Description: "Hilbert Transform - Dominant Cycle Phase",
+		Inputs:      []string{"real"},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: HtDcPhase,
+	},
+	{
+		Name:        "HT_PHASOR",
+		GoName:      "HtPhasor",
+		Description: "Hilbert Transform - Phasor Components",
+		Inputs:      []string{"real"},
+		Outputs: []OutputInfo{
+			{Name: "inPhase"},
+			{Name: "quadrature"},
+		},
+		fn: HtPhasor,
+	},
+	{
+		Name:        "HT_SINE",
+		GoName:      "HtSine",
+		Description: "Hilbert Transform - SineWave",
+		Inputs:      []string{"real"},
+		Outputs: []OutputInfo{
+			{Name: "sine"},
+			{Name: "leadSine"},
+		},
+		fn: HtSine,
+	},
+	{
+		Name:        "HT_TRENDLINE",
+		GoName:      "HtTrendLine",
+		Description: "Hilbert Transform - Instantaneous Trendline",
+		Inputs:      []string{"real"},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: HtTrendLine,
+	},
+	{
+		Name:        "HT_TRENDMODE",
+		GoName:      "HtTrendMode",
+		Description: "Hilbert Transform - Trend vs Cycle Mode",
+		Inputs:      []string{"real"},
+		Outputs: []OutputInfo{
+			{Name: "integer", Integer: true},
+		},
+		fn: HtTrendMode,
+	},
+	{
+		Name:        "KAMA",
+		GoName:      "Kama",
+		Description: "Kaufman Adaptive Moving Average",
+		Inputs:      []string{"real"},
+		Options: []OptionInfo{
+			{Name: "timePeriod", Type: OptionInteger, Min: 2, Max: 100000, Description: "Number of period"},
+		},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: Kama,
+	},
+	{
+		Name:        "LINEARREG",
+		GoName:      "LinearReg",
+		Description: "Linear Regression",
+		Inputs:      []string{"real"},
+		Options: []OptionInfo{
+			{Name: "timePeriod", Type: OptionInteger, Min: 2, Max: 100000, Description: "Number of period"},
+		},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: LinearReg,
+	},
+	{
+		Name:        "LINEARREG_ANGLE",
+		GoName:      "LinearRegAngle",
+		Description: "Linear Regression Angle",
+		Inputs:      []string{"real"},
+		Options: []OptionInfo{
+			{Name: "timePeriod", Type: OptionInteger, Min: 2, Max: 100000, Description: "Number of period"},
+		},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: LinearRegAngle,
+	},
+	{
+		Name:        "LINEARREG_INTERCEPT",
+		GoName:      "LinearRegIntercept",
+		Description: "Linear Regression Intercept",
+		Inputs:      []string{"real"},
+		Options: []OptionInfo{
+			{Name: "timePeriod", Type: OptionInteger, Min: 2, Max: 100000, Description: "Number of period"},
+		},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: LinearRegIntercept,
+	},
+	{
+		Name:        "LINEARREG_SLOPE",
+		GoName:      "LinearRegSlope",
+		Description: "Linear Regression Slope",
+		Inputs:      []string{"real"},
+		Options: []OptionInfo{
+			{Name: "timePeriod", Type: OptionInteger, Min: 2, Max: 100000, Description: "Number of period"},
+		},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: LinearRegSlope,
+	},
+	{
+		Name:        "LN",
+		GoName:      "Ln",
+		Description: "Vector Log Natural",
+		Inputs:      []string{"real"},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: Ln,
+	},
+	{
+		Name:        "LOG10",
+		GoName:      "Log10",
+		Description: "Vector Log10",
+		Inputs:      []string{"real"},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: Log10,
+	},
+	{
+		Name:        "MA",
+		GoName:      "Ma",
+		Description: "Moving average",
+		Inputs:      []string{"real"},
+		Options: []OptionInfo{
+			{Name: "timePeriod", Type: OptionInteger, Min: 1, Max: 100000, Description: "Number of period"},
+			{Name: "mAType", Type: OptionMAType, Min: 0, Max: MAType_T3, Description: "Type of Moving Average"},
+		},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: Ma,
+	},
+	{
+		Name:        "MACD",
+		GoName:      "Macd",
+		Description: "Moving Average Convergence/Divergence",
+		Inputs:      []string{"real"},
+		Options: []OptionInfo{
+			{Name: "fastPeriod", Type: OptionInteger, Min: 2, Max: 100000, Description: "Number of period for the fast MA"},
+			{Name: "slowPeriod", Type: OptionInteger, Min: 2, Max: 100000, Description: "Number of period for the slow MA"},
+			{Name: "signalPeriod", Type: OptionInteger, Min: 1, Max: 100000, Description: "Smoothing for the signal line (nb of period)"},
+		},
+		Outputs: []OutputInfo{
+			{Name: "macd"},
+			{Name: "macdSignal"},
+			{Name: "macdHist"},
+		},
+		fn: Macd,
+	},
+	{
+		Name:        "MACDEXT",
+		GoName:      "MacdExt",
+		Description: "MACD with controllable MA type",
+		Inputs:      []string{"real"},
+		Options: []OptionInfo{
+			{Name: "fastPeriod", Type: OptionInteger, Min: 2, Max: 100000, Description: "Number of period for the fast MA"},
+			{Name: "fastMAType", Type: OptionMAType, Min: 0, Max: MAType_T3, Description: "Type of Moving Average for fast MA"},
+			{Name: "slowPeriod", Type: OptionInteger, Min: 2, Max: 100000, Description: "Number of period for the slow MA"},
+			{Name: "slowMAType", Type: OptionMAType, Min: 0, Max: MAType_T3, Description: "Type of Moving Average for slow MA"},
+			{Name: "signalPeriod", Type: OptionInteger, Min: 1, Max: 100000, Description: "Smoothing for the signal line (nb of period)"},
+			{Name: "signalMAType", Type: OptionMAType, Min: 0, Max: MAType_T3, Description: "Type of Moving Average for signal line"},
+		},
+		Outputs: []OutputInfo{
+			{Name: "macd"},
+			{Name: "macdSignal"},
+			{Name: "macdHist"},
+		},
+		fn: MacdExt,
+	},
+	{
+		Name:        "MACDFIX",
+		GoName:      "MacdFix",
+		Description: "Moving Average Convergence/Divergence Fix 12/26",
+		Inputs:      []string{"real"},
+		Options: []OptionInfo{
+			{Name: "signalPeriod", Type: OptionInteger, Min: 1, Max: 100000, Description: "Smoothing for the signal line (nb of period)"},
+		},
+		Outputs: []OutputInfo{
+			{Name: "macd"},
+			{Name: "macdSignal"},
+			{Name: "macdHist"},
+		},
+		fn: MacdFix,
+	},
+	{
+		Name:        "MAMA",
+		GoName:      "Mama",
+		Description: "MESA Adaptive Moving Average",
+		Inputs:      []string{"real"},
+		Options: []OptionInfo{
+			{Name: "fastLimit", Type: OptionReal, Min: 0.01, Max: 0.99, Description: "Upper limit use in the adaptive algorithm"},
+			{Name: "slowLimit", Type: OptionReal, Min: 0.01, Max: 0.99, Description: "Lower limit use in the adaptive algorithm"},
+		},
+		Outputs: []OutputInfo{
+			{Name: "mama"},
+			{Name: "fama"},
+		},
+		fn: Mama,
+	},
+	{
+		Name:        "MAVP",
+		GoName:      "Mavp",
+		Description: "Moving average with variable period",
+		Inputs:      []string{"real", "periods"},
+		Options: []OptionInfo{
+			{Name: "minPeriod", Type: OptionInteger, Min: 2, Max: 100000, Description: "Value less than minimum will be changed to Minimum period"},
+			{Name: "maxPeriod", Type: OptionInteger, Min: 2, Max: 100000, Description: "Value higher than maximum will be changed to Maximum period"},
+			{Name: "mAType", Type: OptionMAType, Min: 0, Max: MAType_T3, Description: "Type of Moving Average"},
+		},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: Mavp,
+	},
+	{
+		Name:        "MAX",
+		GoName:      "Max",
+		Description: "Highest value over a specified period",
+		Inputs:      []string{"real"},
+		Options: []OptionInfo{
+			{Name: "timePeriod", Type: OptionInteger, Min: 2, Max: 100000, Description: "Number of period"},
+		},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: Max,
+	},
+	{
+		Name:        "MAXINDEX",
+		GoName:      "MaxIndex",
+		Description: "Index of highest value over a specified period",
+		Inputs:      []string{"real"},
+		Options: []OptionInfo{
+			{Name: "timePeriod", Type: OptionInteger, Min: 2, Max: 100000, Description: "Number of period"},
+		},
+		Outputs: []OutputInfo{
+			{Name: "integer", Integer: true},
+		},
+		fn: MaxIndex,
+	},
+	{
+		Name:        "MEDPRICE",
+		GoName:      "MedPrice",
+		Description: "Median Price",
+		Inputs:      []string{"high", "low"},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: MedPrice,
+	},
+	{
+		Name:        "MFI",
+		GoName:      "Mfi",
+		Description: "Money Flow Index",
+		Inputs:      []string{"high", "low", "close", "volume"},
+		Options: []OptionInfo{
+			{Name: "timePeriod", Type: OptionInteger, Min: 2, Max: 100000, Description: "Number of period"},
+		},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: Mfi,
+	},
+	{
+		Name:        "MIDPOINT",
+		GoName:      "MidPoint",
+		Description: "MidPoint over period",
+		Inputs:      []string{"real"},
+		Options: []OptionInfo{
+			{Name: "timePeriod", Type: OptionInteger, Min: 2, Max: 100000, Description: "Number of period"},
+		},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: MidPoint,
+	},
+	{
+		Name:        "MIDPRICE",
+		GoName:      "MidPrice",
+		Description: "Midpoint Price over period",
+		Inputs:      []string{"high", "low"},
+		Options: []OptionInfo{
+			{Name: "timePeriod", Type: OptionInteger, Min: 2, Max: 100000, Description: "Number of period"},
+		},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: MidPrice,
+	},
+	{
+		Name:        "MIN",
+		GoName:      "Min",
+		Description: "Lowest value over a specified period",
+		Inputs:      []string{"real"},
+		Options: []OptionInfo{
+			{Name: "timePeriod", Type: OptionInteger, Min: 2, Max: 100000, Description: "Number of period"},
+		},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: Min,
+	},
+	{
+		Name:        "MININDEX",
+		GoName:      "MinIndex",
+		Description: "Index of lowest value over a specified period",
+		Inputs:      []string{"real"},
+		Options: []OptionInfo{
+			{Name: "timePeriod", Type: OptionInteger, Min: 2, Max: 100000, Description: "Number of period"},
+		},
+		Outputs: []OutputInfo{
+			{Name: "integer", Integer: true},
+		},
+		fn: MinIndex,
+	},
+	{
+		Name:        "MINMAX",
+		GoName:      "MinMax",
+		Description: "Lowest and highest values over a specified period",
+		Inputs:      []string{"real"},
+		Options: []OptionInfo{
+			{Name: "timePeriod", Type: OptionInteger, Min: 2, Max: 100000, Description: "Number of period"},
+		},
+		Outputs: []OutputInfo{
+			{Name: "min"},
+			{Name: "max"},
+		},
+		fn: MinMax,
+	},
+	{
+		Name:        "MINMAXINDEX",
+		GoName:      "MinMaxIndex",
+		Description: "Indexes of lowest and highest values over a specified period",
+		Inputs:      []string{"real"},
+		Options: []OptionInfo{
+			{Name: "timePeriod", Type: OptionInteger, Min: 2, Max: 100000, Description: "Number of period"},
+		},
+		Outputs: []OutputInfo{
+			{Name: "minIdx", Integer: true},
+			{Name: "maxIdx", Integer: true},
+		},
+		fn: MinMaxIndex,
+	},
+	{
+		Name:        "MINUS_DI",
+		GoName:      "MinusDi",
+		Description: "Minus Directional Indicator",
+		Inputs:      []string{"high", "low", "close"},
+		Options: []OptionInfo{
+			{Name: "timePeriod", Type: OptionInteger, Min: 1, Max: 100000, Description: "Number of period"},
+		},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: MinusDi,
+	},
+	{
+		Name:        "MINUS_DM",
+		GoName:      "MinusDm",
+		Description: "Minus Directional Movement",
+		Inputs:      []string{"high", "low"},
+		Options: []OptionInfo{
+			{Name: "timePeriod", Type: OptionInteger, Min: 1, Max: 100000, Description: "Number of period"},
+		},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: MinusDm,
+	},
+	{
+		Name:        "MOM",
+		GoName:      "Mom",
+		Description: "Momentum",
+		Inputs:      []string{"real"},
+		Options: []OptionInfo{
+			{Name: "timePeriod", Type: OptionInteger, Min: 1, Max: 100000, Description: "Number of period"},
+		},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: Mom,
+	},
+	{
+		Name:        "MULT",
+		GoName:      "Mult",
+		Description: "Vector Arithmetic Mult",
+		Inputs:      []string{"real0", "real1"},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: Mult,
+	},
+	{
+		Name:        "NATR",
+		GoName:      "Natr",
+		Description: "Normalized Average True Range",
+		Inputs:      []string{"high", "low", "close"},
+		Options: []OptionInfo{
+			{Name: "timePeriod", Type: OptionInteger, Min: 1, Max: 100000, Description: "Number of period"},
+		},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: Natr,
+	},
+	{
+		Name:        "OBV",
+		GoName:      "Obv",
+		Description: "On Balance Volume",
+		Inputs:      []string{"real", "volume"},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: Obv,
+	},
+	{
+		Name:        "PLUS_DI",
+		GoName:      "PlusDi",
+		Description: "Plus Directional Indicator",
+		Inputs:      []string{"high", "low", "close"},
+		Options: []OptionInfo{
+			{Name: "timePeriod", Type: OptionInteger, Min: 1, Max: 100000, Description: "Number of period"},
+		},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: PlusDi,
+	},
+	{
+		Name:        "PLUS_DM",
+		GoName:      "PlusDm",
+		Description: "Plus Directional Movement",
+		Inputs:      []string{"high", "low"},
+		Options: []OptionInfo{
+			{Name: "timePeriod", Type: OptionInteger, Min: 1, Max: 100000, Description: "Number of period"},
+		},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: PlusDm,
+	},
+	{
+		Name:        "PPO",
+		GoName:      "Ppo",
+		Description: "Percentage Price Oscillator",
+		Inputs:      []string{"real"},
+		Options: []OptionInfo{
+			{Name: "fastPeriod", Type: OptionInteger, Min: 2, Max: 100000, Description: "Number of period for the fast MA"},
+			{Name: "slowPeriod", Type: OptionInteger, Min: 2, Max: 100000, Description: "Number of period for the slow MA"},
+			{Name: "mAType", Type: OptionMAType, Min: 0, Max: MAType_T3, Description: "Type of Moving Average"},
+		},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: Ppo,
+	},
+	{
+		Name:        "ROC",
+		GoName:      "Roc",
+		Description: "Rate of change : ((price/prevPrice)-1)*100",
+		Inputs:      []string{"real"},
+		Options: []OptionInfo{
+			{Name: "timePeriod", Type: OptionInteger, Min: 1, Max: 100000, Description: "Number of period"},
+		},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: Roc,
+	},
+	{
+		Name:        "ROCP",
+		GoName:      "Rocp",
+		Description: "Rate of change Percentage: (price-prevPrice)/prevPrice",
+		Inputs:      []string{"real"},
+		Options: []OptionInfo{
+			{Name: "timePeriod", Type: OptionInteger, Min: 1, Max: 100000, Description: "Number of period"},
+		},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: Rocp,
+	},
+	{
+		Name:        "ROCR",
+		GoName:      "Rocr",
+		Description: "Rate of change ratio: (price/prevPrice)",
+		Inputs:      []string{"real"},
+		Options: []OptionInfo{
+			{Name: "timePeriod", Type: OptionInteger, Min: 1, Max: 100000, Description: "Number of period"},
+		},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: Rocr,
+	},
+	{
+		Name:        "ROCR100",
+		GoName:      "Rocr100",
+		Description: "Rate of change ratio 100 scale: (price/prevPrice)*100",
+		Inputs:      []string{"real"},
+		Options: []OptionInfo{
+			{Name: "timePeriod", Type: OptionInteger, Min: 1, Max: 100000, Description: "Number of period"},
+		},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: Rocr100,
+	},
+	{
+		Name:        "RSI",
+		GoName:      "Rsi",
+		Description: "Relative Strength Index",
+		Inputs:      []string{"real"},
+		Options: []OptionInfo{
+			{Name: "timePeriod", Type: OptionInteger, Min: 2, Max: 100000, Description: "Number of period"},
+		},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: Rsi,
+	},
+	{
+		Name:        "SAR",
+		GoName:      "Sar",
+		Description: "Parabolic Sar",
+		Inputs:      []string{"high", "low"},
+		Options: []OptionInfo{
+			{Name: "acceleration", Type: OptionReal, Min: 0, Max: math.MaxFloat64, Description: "Acceleration Factor used up to the Maximum value"},
+			{Name: "maximum", Type: OptionReal, Min: 0, Max: math.MaxFloat64, Description: "Acceleration Factor Maximum value"},
+		},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: Sar,
+	},
+	{
+		Name:        "SAREXT",
+		GoName:      "SarExt",
+		Description: "Parabolic SAR - Extended",
+		Inputs:      []string{"high", "low"},
+		Options: []OptionInfo{
+			{Name: "startValue", Type: OptionReal, Min: -math.MaxFloat64, Max: math.MaxFloat64, Description: "Start value and direction. 0 for Auto, >0 for Long, <0 for Short"},
+			{Name: "offsetOnReverse", Type: OptionReal, Min: 0, Max: math.MaxFloat64, Description: "Percent offset added/removed to initial stop on short/long reversal"},
+			{Name: "accelerationInitLong", Type: OptionReal, Min: 0, Max: math.MaxFloat64, Description: "Acceleration Factor initial value for the Long direction"},
+			{Name: "accelerationLong", Type: OptionReal, Min: 0, Max: math.MaxFloat64, Description: "Acceleration Factor for the Long direction"},
+			{Name: "accelerationMaxLong", Type: OptionReal, Min: 0, Max: math.MaxFloat64, Description: "Acceleration Factor maximum value for the Long direction"},
+			{Name: "accelerationInitShort", Type: OptionReal, Min: 0, Max: math.MaxFloat64, Description: "Acceleration Factor initial value for the Short direction"},
+			{Name: "accelerationShort", Type: OptionReal, Min: 0, Max: math.MaxFloat64, Description: "Acceleration Factor for the Short direction"},
+			{Name: "accelerationMaxShort", Type: OptionReal, Min: 0, Max: math.MaxFloat64, Description: "Acceleration Factor maximum value for the Short direction"},
+		},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: SarExt,
+	},
+	{
+		Name:        "SIN",
+		GoName:      "Sin",
+		Description: "Vector Trigonometric Sin",
+		Inputs:      []string{"real"},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: Sin,
+	},
+	{
+		Name:        "SINH",
+		GoName:      "Sinh",
+		Description: "Vector Trigonometric Sinh",
+		Inputs:      []string{"real"},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: Sinh,
+	},
+	{
+		Name:        "SMA",
+		GoName:      "Sma",
+		Description: "Simple Moving Average",
+		Inputs:      []string{"real"},
+		Options: []OptionInfo{
+			{Name: "timePeriod", Type: OptionInteger, Min: 2, Max: 100000, Description: "Number of period"},
+		},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: Sma,
+	},
+	{
+		Name:        "SQRT",
+		GoName:      "Sqrt",
+		Description: "Vector Square Root",
+		Inputs:      []string{"real"},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: Sqrt,
+	},
+	{
+		Name:        "STDDEV",
+		GoName:      "StdDev",
+		Description: "Standard Deviation",
+		Inputs:      []string{"real"},
+		Options: []OptionInfo{
+			{Name: "timePeriod", Type: OptionInteger, Min: 2, Max: 100000, Description: "Number of period"},
+			{Name: "nbDev", Type: OptionReal, Min: -math.MaxFloat64, Max: math.MaxFloat64, Description: "Nb of deviations"},
+		},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: StdDev,
+	},
+	{
+		Name:        "STOCH",
+		GoName:      "Stoch",
+		Description: "Stochastic",
+		Inputs:      []string{"high", "low", "close"},
+		Options: []OptionInfo{
+			{Name: "fastKPeriod", Type: OptionInteger, Min: 1, Max: 100000, Description: "Time period for building the Fast-K line"},
+			{Name: "slowKPeriod", Type: OptionInteger, Min: 1, Max: 100000, Description: "Smoothing for making the Slow-K line. Usually set to 3"},
+			{Name: "slowKMAType", Type: OptionMAType, Min: 0, Max: MAType_T3, Description: "Type of Moving Average for Slow-K"},
+			{Name: "slowDPeriod", Type: OptionInteger, Min: 1, Max: 100000, Description: "Smoothing for making the Slow-D line"},
+			{Name: "slowDMAType", Type: OptionMAType, Min: 0, Max: MAType_T3, Description: "Type of Moving Average for Slow-D"},
+		},
+		Outputs: []OutputInfo{
+			{Name: "slowK"},
+			{Name: "slowD"},
+		},
+		fn: Stoch,
+	},
+	{
+		Name:        "STOCHF",
+		GoName:      "Stochf",
+		Description: "Stochastic Fast",
+		Inputs:      []string{"high", "low", "close"},
+		Options: []OptionInfo{
+			{Name: "fastKPeriod", Type: OptionInteger, Min: 1, Max: 100000, Description: "Time period for building the Fast-K line"},
+			{Name: "fastDPeriod", Type: OptionInteger, Min: 1, Max: 100000, Description: "Smoothing for making the Fast-D line. Usually set to 3"},
+			{Name: "fastDMAType", Type: OptionMAType, Min: 0, Max: MAType_T3, Description: "Type of Moving Average for Fast-D"},
+		},
+		Outputs: []OutputInfo{
+			{Name: "fastK"},
+			{Name: "fastD"},
+		},
+		fn: Stochf,
+	},
+	{
+		Name:        "STOCHRSI",
+		GoName:      "StochRsi",
+		Description: "Stochastic Relative Strength Index",
+		Inputs:      []string{"real"},
+		Options: []OptionInfo{
+			{Name: "timePeriod", Type: OptionInteger, Min: 2, Max: 100000, Description: "Number of period"},
+			{Name: "fastKPeriod", Type: OptionInteger, Min: 1, Max: 100000, Description: "Time period for building the Fast-K line"},
+			{Name: "fastDPeriod", Type: OptionInteger, Min: 1, Max: 100000, Description: "Smoothing for making the Fast-D line. Usually set to 3"},
+			{Name: "fastDMAType", Type: OptionMAType, Min: 0, Max: MAType_T3, Description: "Type of Moving Average for Fast-D"},
+		},
+		Outputs: []OutputInfo{
+			{Name: "fastK"},
+			{Name: "fastD"},
+		},
+		fn: StochRsi,
+	},
+	{
+		Name:        "SUB",
+		GoName:      "Sub",
+		Description: "Vector Arithmetic Substraction",
+		Inputs:      []string{"real0", "real1"},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: Sub,
+	},
+	{
+		Name:        "SUM",
+		GoName:      "Sum",
+		Description: "Summation",
+		Inputs:      []string{"real"},
+		Options: []OptionInfo{
+			{Name: "timePeriod", Type: OptionInteger, Min: 2, Max: 100000, Description: "Number of period"},
+		},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: Sum,
+	},
+	{
+		Name:        "T3",
+		GoName:      "T3",
+		Description: "Triple Exponential Moving Average (T3)",
+		Inputs:      []string{"real"},
+		Options: []OptionInfo{
+			{Name: "timePeriod", Type: OptionInteger, Min: 2, Max: 100000, Description: "Number of period"},
+			{Name: "vFactor", Type: OptionReal, Min: 0, Max: 1, Description: "Volume Factor"},
+		},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: T3,
+	},
+	{
+		Name:        "TAN",
+		GoName:      "Tan",
+		Description: "Vector Trigonometric Tan",
+		Inputs:      []string{"real"},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: Tan,
+	},
+	{
+		Name:        "TANH",
+		GoName:      "Tanh",
+		Description: "Vector Trigonometric Tanh",
+		Inputs:      []string{"real"},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: Tanh,
+	},
+	{
+		Name:        "TEMA",
+		GoName:      "Tema",
+		Description: "Triple Exponential Moving Average",
+		Inputs:      []string{"real"},
+		Options: []OptionInfo{
+			{Name: "timePeriod", Type: OptionInteger, Min: 2, Max: 100000, Description: "Number of period"},
+		},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: Tema,
+	},
+	{
+		Name:        "TRANGE",
+		GoName:      "Trange",
+		Description: "True Range",
+		Inputs:      []string{"high", "low", "close"},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: Trange,
+	},
+	{
+		Name:        "TRIMA",
+		GoName:      "TriMa",
+		Description: "Triangular Moving Average",
+		Inputs:      []string{"real"},
+		Options: []OptionInfo{
+			{Name: "timePeriod", Type: OptionInteger, Min: 2, Max: 100000, Description: "Number of period"},
+		},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: TriMa,
+	},
+	{
+		Name:        "TRIX",
+		GoName:      "Trix",
+		Description: "1-day Rate-Of-Change (ROC) of a Triple Smooth EMA",
+		Inputs:      []string{"real"},
+		Options: []OptionInfo{
+			{Name: "timePeriod", Type: OptionInteger, Min: 1, Max: 100000, Description: "Number of period"},
+		},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: Trix,
+	},
+	{
+		Name:        "TSF",
+		GoName:      "Tsf",
+		Description: "Time Series Forecast",
+		Inputs:      []string{"real"},
+		Options: []OptionInfo{
+			{Name: "timePeriod", Type: OptionInteger, Min: 2, Max: 100000, Description: "Number of period"},
+		},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: Tsf,
+	},
+	{
+		Name:        "TYPPRICE",
+		GoName:      "TypPrice",
+		Description: "Typical Price",
+		Inputs:      []string{"high", "low", "close"},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: TypPrice,
+	},
+	{
+		Name:        "ULTOSC",
+		GoName:      "UltOsc",
+		Description: "Ultimate Oscillator",
+		Inputs:      []string{"high", "low", "close"},
+		Options: []OptionInfo{
+			{Name: "timePeriod1", Type: OptionInteger, Min: 1, Max: 100000, Description: "Number of bars for 1st period."},
+			{Name: "timePeriod2", Type: OptionInteger, Min: 1, Max: 100000, Description: "Number of bars fro 2nd period"},
+			{Name: "timePeriod3", Type: OptionInteger, Min: 1, Max: 100000, Description: "Number of bars for 3rd period"},
+		},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: UltOsc,
+	},
+	{
+		Name:        "VAR",
+		GoName:      "Var",
+		Description: "Variance",
+		Inputs:      []string{"real"},
+		Options: []OptionInfo{
+			{Name: "timePeriod", Type: OptionInteger, Min: 1, Max: 100000, Description: "Number of period"},
+			{Name: "nbDev", Type: OptionReal, Min: -math.MaxFloat64, Max: math.MaxFloat64, Description: "Nb of deviations"},
+		},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: Var,
+	},
+	{
+		Name:        "WCLPRICE",
+		GoName:      "WclPrice",
+		Description: "Weighted Close Price",
+		Inputs:      []string{"high", "low", "close"},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: WclPrice,
+	},
+	{
+		Name:        "WILLR",
+		GoName:      "Willr",
+		Description: "Williams' %R",
+		Inputs:      []string{"high", "low", "close"},
+		Options: []OptionInfo{
+			{Name: "timePeriod", Type: OptionInteger, Min: 2, Max: 100000, Description: "Number of period"},
+		},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: Willr,
+	},
+	{
+		Name:        "WMA",
+		GoName:      "Wma",
+		Description: "Weighted Moving Average",
+		Inputs:      []string{"real"},
+		Options: []OptionInfo{
+			{Name: "timePeriod", Type: OptionInteger, Min: 2, Max: 100000, Description: "Number of period"},
+		},
+		Outputs: []OutputInfo{
+			{Name: "real"},
+		},
+		fn: Wma,
+	},
+}
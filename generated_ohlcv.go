@@ -0,0 +1,541 @@
+package talib
+
+// Ad calls Ad with the High, Low, Close and Volume columns of s.
+func (s OHLCV) Ad() ([]float64, int) {
+	s.mustValidate("High", "Low", "Close", "Volume")
+	return Ad(s.High, s.Low, s.Close, s.Volume, nil)
+}
+
+// AdOsc calls AdOsc with the High, Low, Close and Volume columns of s.
+func (s OHLCV) AdOsc(fastPeriod, slowPeriod int) ([]float64, int) {
+	s.mustValidate("High", "Low", "Close", "Volume")
+	return AdOsc(s.High, s.Low, s.Close, s.Volume, fastPeriod, slowPeriod, nil)
+}
+
+// Adx calls Adx with the High, Low and Close columns of s.
+func (s OHLCV) Adx(timePeriod int) ([]float64, int) {
+	s.mustValidate("High", "Low", "Close")
+	return Adx(s.High, s.Low, s.Close, timePeriod, nil)
+}
+
+// Adxr calls Adxr with the High, Low and Close columns of s.
+func (s OHLCV) Adxr(timePeriod int) ([]float64, int) {
+	s.mustValidate("High", "Low", "Close")
+	return Adxr(s.High, s.Low, s.Close, timePeriod, nil)
+}
+
+// AroOn calls AroOn with the High and Low columns of s.
+func (s OHLCV) AroOn(timePeriod int) ([]float64, []float64, int) {
+	s.mustValidate("High", "Low")
+	return AroOn(s.High, s.Low, timePeriod, nil, nil)
+}
+
+// AroOnOsc calls AroOnOsc with the High and Low columns of s.
+func (s OHLCV) AroOnOsc(timePeriod int) ([]float64, int) {
+	s.mustValidate("High", "Low")
+	return AroOnOsc(s.High, s.Low, timePeriod, nil)
+}
+
+// Atr calls Atr with the High, Low and Close columns of s.
+func (s OHLCV) Atr(timePeriod int) ([]float64, int) {
+	s.mustValidate("High", "Low", "Close")
+	return Atr(s.High, s.Low, s.Close, timePeriod, nil)
+}
+
+// AvgPrice calls AvgPrice with the Open, High, Low and Close columns of s.
+func (s OHLCV) AvgPrice() ([]float64, int) {
+	s.mustValidate("Open", "High", "Low", "Close")
+	return AvgPrice(s.Open, s.High, s.Low, s.Close, nil)
+}
+
+// Bop calls Bop with the Open, High, Low and Close columns of s.
+func (s OHLCV) Bop() ([]float64, int) {
+	s.mustValidate("Open", "High", "Low", "Close")
+	return Bop(s.Open, s.High, s.Low, s.Close, nil)
+}
+
+// Cci calls Cci with the High, Low and Close columns of s.
+func (s OHLCV) Cci(timePeriod int) ([]float64, int) {
+	s.mustValidate("High", "Low", "Close")
+	return Cci(s.High, s.Low, s.Close, timePeriod, nil)
+}
+
+// Cdl2Crows calls Cdl2Crows with the Open, High, Low and Close columns of s.
+func (s OHLCV) Cdl2Crows() ([]int, int) {
+	s.mustValidate("Open", "High", "Low", "Close")
+	return Cdl2Crows(s.Open, s.High, s.Low, s.Close, nil)
+}
+
+// Cdl3BlackCrows calls Cdl3BlackCrows with the Open, High, Low and Close columns of s.
+func (s OHLCV) Cdl3BlackCrows() ([]int, int) {
+	s.mustValidate("Open", "High", "Low", "Close")
+	return Cdl3BlackCrows(s.Open, s.High, s.Low, s.Close, nil)
+}
+
+// Cdl3Inside calls Cdl3Inside with the Open, High, Low and Close columns of s.
+func (s OHLCV) Cdl3Inside() ([]int, int) {
+	s.mustValidate("Open", "High", "Low", "Close")
+	return Cdl3Inside(s.Open, s.High, s.Low, s.Close, nil)
+}
+
+// Cdl3LineStrike calls Cdl3LineStrike with the Open, High, Low and Close columns of s.
+func (s OHLCV) Cdl3LineStrike() ([]int, int) {
+	s.mustValidate("Open", "High", "Low", "Close")
+	return Cdl3LineStrike(s.Open, s.High, s.Low, s.Close, nil)
+}
+
+// Cdl3Outside calls Cdl3Outside with the Open, High, Low and Close columns of s.
+func (s OHLCV) Cdl3Outside() ([]int, int) {
+	s.mustValidate("Open", "High", "Low", "Close")
+	return Cdl3Outside(s.Open, s.High, s.Low, s.Close, nil)
+}
+
+// Cdl3StarsinSouth calls Cdl3StarsinSouth with the Open, High, Low and Close columns of s.
+func (s OHLCV) Cdl3StarsinSouth() ([]int, int) {
+	s.mustValidate("Open", "High", "Low", "Close")
+	return Cdl3StarsinSouth(s.Open, s.High, s.Low, s.Close, nil)
+}
+
+// Cdl3WhiteSoldiers calls Cdl3WhiteSoldiers with the Open, High, Low and Close columns of s.
+func (s OHLCV) Cdl3WhiteSoldiers() ([]int, int) {
+	s.mustValidate("Open", "High", "Low", "Close")
+	return Cdl3WhiteSoldiers(s.Open, s.High, s.Low, s.Close, nil)
+}
+
+// CdlAbandonedBaby calls CdlAbandonedBaby with the Open, High, Low and Close columns of s.
+func (s OHLCV) CdlAbandonedBaby(penetration float64) ([]int, int) {
+	s.mustValidate("Open", "High", "Low", "Close")
+	return CdlAbandonedBaby(s.Open, s.High, s.Low, s.Close, penetration, nil)
+}
+
+// CdlAdvanceBlock calls CdlAdvanceBlock with the Open, High, Low and Close columns of s.
+func (s OHLCV) CdlAdvanceBlock() ([]int, int) {
+	s.mustValidate("Open", "High", "Low", "Close")
+	return CdlAdvanceBlock(s.Open, s.High, s.Low, s.Close, nil)
+}
+
+// CdlBelthold calls CdlBelthold with the Open, High, Low and Close columns of s.
+func (s OHLCV) CdlBelthold() ([]int, int) {
+	s.mustValidate("Open", "High", "Low", "Close")
+	return CdlBelthold(s.Open, s.High, s.Low, s.Close, nil)
+}
+
+// CdlBreakaway calls CdlBreakaway with the Open, High, Low and Close columns of s.
+func (s OHLCV) CdlBreakaway() ([]int, int) {
+	s.mustValidate("Open", "High", "Low", "Close")
+	return CdlBreakaway(s.Open, s.High, s.Low, s.Close, nil)
+}
+
+// CdlClosingMarubozu calls CdlClosingMarubozu with the Open, High, Low and Close columns of s.
+func (s OHLCV) CdlClosingMarubozu() ([]int, int) {
+	s.mustValidate("Open", "High", "Low", "Close")
+	return CdlClosingMarubozu(s.Open, s.High, s.Low, s.Close, nil)
+}
+
+// CdlConcealBabySwall calls CdlConcealBabySwall with the Open, High, Low and Close columns of s.
+func (s OHLCV) CdlConcealBabySwall() ([]int, int) {
+	s.mustValidate("Open", "High", "Low", "Close")
+	return CdlConcealBabySwall(s.Open, s.High, s.Low, s.Close, nil)
+}
+
+// CdlCounterattack calls CdlCounterattack with the Open, High, Low and Close columns of s.
+func (s OHLCV) CdlCounterattack() ([]int, int) {
+	s.mustValidate("Open", "High", "Low", "Close")
+	return CdlCounterattack(s.Open, s.High, s.Low, s.Close, nil)
+}
+
+// CdlDarkCloudCover calls CdlDarkCloudCover with the Open, High, Low and Close columns of s.
+func (s OHLCV) CdlDarkCloudCover(penetration float64) ([]int, int) {
+	s.mustValidate("Open", "High", "Low", "Close")
+	return CdlDarkCloudCover(s.Open, s.High, s.Low, s.Close, penetration, nil)
+}
+
+// CdlDoji calls CdlDoji with the Open, High, Low and Close columns of s.
+func (s OHLCV) CdlDoji() ([]int, int) {
+	s.mustValidate("Open", "High", "Low", "Close")
+	return CdlDoji(s.Open, s.High, s.Low, s.Close, nil)
+}
+
+// CdlDojiStar calls CdlDojiStar with the Open, High, Low and Close columns of s.
+func (s OHLCV) CdlDojiStar() ([]int, int) {
+	s.mustValidate("Open", "High", "Low", "Close")
+	return CdlDojiStar(s.Open, s.High, s.Low, s.Close, nil)
+}
+
+// CdlDragonflyDoji calls CdlDragonflyDoji with the Open, High, Low and Close columns of s.
+func (s OHLCV) CdlDragonflyDoji() ([]int, int) {
+	s.mustValidate("Open", "High", "Low", "Close")
+	return CdlDragonflyDoji(s.Open, s.High, s.Low, s.Close, nil)
+}
+
+// CdlEngulfing calls CdlEngulfing with the Open, High, Low and Close columns of s.
+func (s OHLCV) CdlEngulfing() ([]int, int) {
+	s.mustValidate("Open", "High", "Low", "Close")
+	return CdlEngulfing(s.Open, s.High, s.Low, s.Close, nil)
+}
+
+// CdlEveningDojiStar calls CdlEveningDojiStar with the Open, High, Low and Close columns of s.
+func (s OHLCV) CdlEveningDojiStar(penetration float64) ([]int, int) {
+	s.mustValidate("Open", "High", "Low", "Close")
+	return CdlEveningDojiStar(s.Open, s.High, s.Low, s.Close, penetration, nil)
+}
+
+// CdlEveningStar calls CdlEveningStar with the Open, High, Low and Close columns of s.
+func (s OHLCV) CdlEveningStar(penetration float64) ([]int, int) {
+	s.mustValidate("Open", "High", "Low", "Close")
+	return CdlEveningStar(s.Open, s.High, s.Low, s.Close, penetration, nil)
+}
+
+// CdlGapSidesideWhite calls CdlGapSidesideWhite with the Open, High, Low and Close columns of s.
+func (s OHLCV) CdlGapSidesideWhite() ([]int, int) {
+	s.mustValidate("Open", "High", "Low", "Close")
+	return CdlGapSidesideWhite(s.Open, s.High, s.Low, s.Close, nil)
+}
+
+// CdlGravestoneDoji calls CdlGravestoneDoji with the Open, High, Low and Close columns of s.
+func (s OHLCV) CdlGravestoneDoji() ([]int, int) {
+	s.mustValidate("Open", "High", "Low", "Close")
+	return CdlGravestoneDoji(s.Open, s.High, s.Low, s.Close, nil)
+}
+
+// CdlHammer calls CdlHammer with the Open, High, Low and Close columns of s.
+func (s OHLCV) CdlHammer() ([]int, int) {
+	s.mustValidate("Open", "High", "Low", "Close")
+	return CdlHammer(s.Open, s.High, s.Low, s.Close, nil)
+}
+
+// CdlHangingMan calls CdlHangingMan with the Open, High, Low and Close columns of s.
+func (s OHLCV) CdlHangingMan() ([]int, int) {
+	s.mustValidate("Open", "High", "Low", "Close")
+	return CdlHangingMan(s.Open, s.High, s.Low, s.Close, nil)
+}
+
+// CdlHarami calls CdlHarami with the Open, High, Low and Close columns of s.
+func (s OHLCV) CdlHarami() ([]int, int) {
+	s.mustValidate("Open", "High", "Low", "Close")
+	return CdlHarami(s.Open, s.High, s.Low, s.Close, nil)
+}
+
+// CdlHaramiCross calls CdlHaramiCross with the Open, High, Low and Close columns of s.
+func (s OHLCV) CdlHaramiCross() ([]int, int) {
+	s.mustValidate("Open", "High", "Low", "Close")
+	return CdlHaramiCross(s.Open, s.High, s.Low, s.Close, nil)
+}
+
+// CdlHighWave calls CdlHighWave with the Open, High, Low and Close columns of s.
+func (s OHLCV) CdlHighWave() ([]int, int) {
+	s.mustValidate("Open", "High", "Low", "Close")
+	return CdlHighWave(s.Open, s.High, s.Low, s.Close, nil)
+}
+
+// CdlHikkake calls CdlHikkake with the Open, High, Low and Close columns of s.
+func (s OHLCV) CdlHikkake() ([]int, int) {
+	s.mustValidate("Open", "High", "Low", "Close")
+	return CdlHikkake(s.Open, s.High, s.Low, s.Close, nil)
+}
+
+// CdlHikkakeMod calls CdlHikkakeMod with the Open, High, Low and Close columns of s.
+func (s OHLCV) CdlHikkakeMod() ([]int, int) {
+	s.mustValidate("Open", "High", "Low", "Close")
+	return CdlHikkakeMod(s.Open, s.High, s.Low, s.Close, nil)
+}
+
+// CdlHomingPigeon calls CdlHomingPigeon with the Open, High, Low and Close columns of s.
+func (s OHLCV) CdlHomingPigeon() ([]int, int) {
+	s.mustValidate("Open", "High", "Low", "Close")
+	return CdlHomingPigeon(s.Open, s.High, s.Low, s.Close, nil)
+}
+
+// CdlIdentical3Crows calls CdlIdentical3Crows with the Open, High, Low and Close columns of s.
+func (s OHLCV) CdlIdentical3Crows() ([]int, int) {
+	s.mustValidate("Open", "High", "Low", "Close")
+	return CdlIdentical3Crows(s.Open, s.High, s.Low, s.Close, nil)
+}
+
+// CdlInNeck calls CdlInNeck with the Open, High, Low and Close columns of s.
+func (s OHLCV) CdlInNeck() ([]int, int) {
+	s.mustValidate("Open", "High", "Low", "Close")
+	return CdlInNeck(s.Open, s.High, s.Low, s.Close, nil)
+}
+
+// CdlInvertedHammer calls CdlInvertedHammer with the Open, High, Low and Close columns of s.
+func (s OHLCV) CdlInvertedHammer() ([]int, int) {
+	s.mustValidate("Open", "High", "Low", "Close")
+	return CdlInvertedHammer(s.Open, s.High, s.Low, s.Close, nil)
+}
+
+// CdlKicking calls CdlKicking with the Open, High, Low and Close columns of s.
+func (s OHLCV) CdlKicking() ([]int, int) {
+	s.mustValidate("Open", "High", "Low", "Close")
+	return CdlKicking(s.Open, s.High, s.Low, s.Close, nil)
+}
+
+// CdlKickingByLength calls CdlKickingByLength with the Open, High, Low and Close columns of s.
+func (s OHLCV) CdlKickingByLength() ([]int, int) {
+	s.mustValidate("Open", "High", "Low", "Close")
+	return CdlKickingByLength(s.Open, s.High, s.Low, s.Close, nil)
+}
+
+// CdlLadderBottom calls CdlLadderBottom with the Open, High, Low and Close columns of s.
+func (s OHLCV) CdlLadderBottom() ([]int, int) {
+	s.mustValidate("Open", "High", "Low", "Close")
+	return CdlLadderBottom(s.Open, s.High, s.Low, s.Close, nil)
+}
+
+// CdlLongLeggedDoji calls CdlLongLeggedDoji with the Open, High, Low and Close columns of s.
+func (s OHLCV) CdlLongLeggedDoji() ([]int, int) {
+	s.mustValidate("Open", "High", "Low", "Close")
+	return CdlLongLeggedDoji(s.Open, s.High, s.Low, s.Close, nil)
+}
+
+// CdlLongLine calls CdlLongLine with the Open, High, Low and Close columns of s.
+func (s OHLCV) CdlLongLine() ([]int, int) {
+	s.mustValidate("Open", "High", "Low", "Close")
+	return CdlLongLine(s.Open, s.High, s.Low, s.Close, nil)
+}
+
+// CdlMarubozu calls CdlMarubozu with the Open, High, Low and Close columns of s.
+func (s OHLCV) CdlMarubozu() ([]int, int) {
+	s.mustValidate("Open", "High", "Low", "Close")
+	return CdlMarubozu(s.Open, s.High, s.Low, s.Close, nil)
+}
+
+// CdlMatchingLow calls CdlMatchingLow with the Open, High, Low and Close columns of s.
+func (s OHLCV) CdlMatchingLow() ([]int, int) {
+	s.mustValidate("Open", "High", "Low", "Close")
+	return CdlMatchingLow(s.Open, s.High, s.Low, s.Close, nil)
+}
+
+// CdlMatHold calls CdlMatHold with the Open, High, Low and Close columns of s.
+func (s OHLCV) CdlMatHold(penetration float64) ([]int, int) {
+	s.mustValidate("Open", "High", "Low", "Close")
+	return CdlMatHold(s.Open, s.High, s.Low, s.Close, penetration, nil)
+}
+
+// CdlMorningDojiStar calls CdlMorningDojiStar with the Open, High, Low and Close columns of s.
+func (s OHLCV) CdlMorningDojiStar(penetration float64) ([]int, int) {
+	s.mustValidate("Open", "High", "Low", "Close")
+	return CdlMorningDojiStar(s.Open, s.High, s.Low, s.Close, penetration, nil)
+}
+
+// CdlMorningStar calls CdlMorningStar with the Open, High, Low and Close columns of s.
+func (s OHLCV) CdlMorningStar(penetration float64) ([]int, int) {
+	s.mustValidate("Open", "High", "Low", "Close")
+	return CdlMorningStar(s.Open, s.High, s.Low, s.Close, penetration, nil)
+}
+
+// CdlOnNeck calls CdlOnNeck with the Open, High, Low and Close columns of s.
+func (s OHLCV) CdlOnNeck() ([]int, int) {
+	s.mustValidate("Open", "High", "Low", "Close")
+	return CdlOnNeck(s.Open, s.High, s.Low, s.Close, nil)
+}
+
+// CdlPiercing calls CdlPiercing with the Open, High, Low and Close columns of s.
+func (s OHLCV) CdlPiercing() ([]int, int) {
+	s.mustValidate("Open", "High", "Low", "Close")
+	return CdlPiercing(s.Open, s.High, s.Low, s.Close, nil)
+}
+
+// CdlRickshawMan calls CdlRickshawMan with the Open, High, Low and Close columns of s.
+func (s OHLCV) CdlRickshawMan() ([]int, int) {
+	s.mustValidate("Open", "High", "Low", "Close")
+	return CdlRickshawMan(s.Open, s.High, s.Low, s.Close, nil)
+}
+
+// CdlRiseFall3Methods calls CdlRiseFall3Methods with the Open, High, Low and Close columns of s.
+func (s OHLCV) CdlRiseFall3Methods() ([]int, int) {
+	s.mustValidate("Open", "High", "Low", "Close")
+	return CdlRiseFall3Methods(s.Open, s.High, s.Low, s.Close, nil)
+}
+
+// CdlSeparatingLines calls CdlSeparatingLines with the Open, High, Low and Close columns of s.
+func (s OHLCV) CdlSeparatingLines() ([]int, int) {
+	s.mustValidate("Open", "High", "Low", "Close")
+	return CdlSeparatingLines(s.Open, s.High, s.Low, s.Close, nil)
+}
+
+// CdlShootingStar calls CdlShootingStar with the Open, High, Low and Close columns of s.
+func (s OHLCV) CdlShootingStar() ([]int, int) {
+	s.mustValidate("Open", "High", "Low", "Close")
+	return CdlShootingStar(s.Open, s.High, s.Low, s.Close, nil)
+}
+
+// CdlShortLine calls CdlShortLine with the Open, High, Low and Close columns of s.
+func (s OHLCV) CdlShortLine() ([]int, int) {
+	s.mustValidate("Open", "High", "Low", "Close")
+	return CdlShortLine(s.Open, s.High, s.Low, s.Close, nil)
+}
+
+// CdlSpinningTop calls CdlSpinningTop with the Open, High, Low and Close columns of s.
+func (s OHLCV) CdlSpinningTop() ([]int, int) {
+	s.mustValidate("Open", "High", "Low", "Close")
+	return CdlSpinningTop(s.Open, s.High, s.Low, s.Close, nil)
+}
+
+// CdlStalledPattern calls CdlStalledPattern with the Open, High, Low and Close columns of s.
+func (s OHLCV) CdlStalledPattern() ([]int, int) {
+	s.mustValidate("Open", "High", "Low", "Close")
+	return CdlStalledPattern(s.Open, s.High, s.Low, s.Close, nil)
+}
+
+// CdlStickSandwich calls CdlStickSandwich with the Open, High, Low and Close columns of s.
+func (s OHLCV) CdlStickSandwich() ([]int, int) {
+	s.mustValidate("Open", "High", "Low", "Close")
+	return CdlStickSandwich(s.Open, s.High, s.Low, s.Close, nil)
+}
+
+// CdlTakuri calls CdlTakuri with the Open, High, Low and Close columns of s.
+func (s OHLCV) CdlTakuri() ([]int, int) {
+	s.mustValidate("Open", "High", "Low", "Close")
+	return CdlTakuri(s.Open, s.High, s.Low, s.Close, nil)
+}
+
+// CdlTasukiGap calls CdlTasukiGap with the Open, High, Low and Close columns of s.
+func (s OHLCV) CdlTasukiGap() ([]int, int) {
+	s.mustValidate("Open", "High", "Low", "Close")
+	return CdlTasukiGap(s.Open, s.High, s.Low, s.Close, nil)
+}
+
+// CdlThrusting calls CdlThrusting with the Open, High, Low and Close columns of s.
+func (s OHLCV) CdlThrusting() ([]int, int) {
+	s.mustValidate("Open", "High", "Low", "Close")
+	return CdlThrusting(s.Open, s.High, s.Low, s.Close, nil)
+}
+
+// CdlTristar calls CdlTristar with the Open, High, Low and Close columns of s.
+func (s OHLCV) CdlTristar() ([]int, int) {
+	s.mustValidate("Open", "High", "Low", "Close")
+	return CdlTristar(s.Open, s.High, s.Low, s.Close, nil)
+}
+
+// CdlUnique3River calls CdlUnique3River with the Open, High, Low and Close columns of s.
+func (s OHLCV) CdlUnique3River() ([]int, int) {
+	s.mustValidate("Open", "High", "Low", "Close")
+	return CdlUnique3River(s.Open, s.High, s.Low, s.Close, nil)
+}
+
+// CdlUpsideGap2Crows calls CdlUpsideGap2Crows with the Open, High, Low and Close columns of s.
+func (s OHLCV) CdlUpsideGap2Crows() ([]int, int) {
+	s.mustValidate("Open", "High", "Low", "Close")
+	return CdlUpsideGap2Crows(s.Open, s.High, s.Low, s.Close, nil)
+}
+
+// CdlxSideGap3Methods calls CdlxSideGap3Methods with the Open, High, Low and Close columns of s.
+func (s OHLCV) CdlxSideGap3Methods() ([]int, int) {
+	s.mustValidate("Open", "High", "Low", "Close")
+	return CdlxSideGap3Methods(s.Open, s.High, s.Low, s.Close, nil)
+}
+
+// Dx calls Dx with the High, Low and Close columns of s.
+func (s OHLCV) Dx(timePeriod int) ([]float64, int) {
+	s.mustValidate("High", "Low", "Close")
+	return Dx(s.High, s.Low, s.Close, timePeriod, nil)
+}
+
+// MedPrice calls MedPrice with the High and Low columns of s.
+func (s OHLCV) MedPrice() ([]float64, int) {
+	s.mustValidate("High", "Low")
+	return MedPrice(s.High, s.Low, nil)
+}
+
+// Mfi calls Mfi with the High, Low, Close and Volume columns of s.
+func (s OHLCV) Mfi(timePeriod int) ([]float64, int) {
+	s.mustValidate("High", "Low", "Close", "Volume")
+	return Mfi(s.High, s.Low, s.Close, s.Volume, timePeriod, nil)
+}
+
+// MidPrice calls MidPrice with the High and Low columns of s.
+func (s OHLCV) MidPrice(timePeriod int) ([]float64, int) {
+	s.mustValidate("High", "Low")
+	return MidPrice(s.High, s.Low, timePeriod, nil)
+}
+
+// MinusDi calls MinusDi with the High, Low and Close columns of s.
+func (s OHLCV) MinusDi(timePeriod int) ([]float64, int) {
+	s.mustValidate("High", "Low", "Close")
+	return MinusDi(s.High, s.Low, s.Close, timePeriod, nil)
+}
+
+// MinusDm calls MinusDm with the High and Low columns of s.
+func (s OHLCV) MinusDm(timePeriod int) ([]float64, int) {
+	s.mustValidate("High", "Low")
+	return MinusDm(s.High, s.Low, timePeriod, nil)
+}
+
+// Natr calls Natr with the High, Low and Close columns of s.
+func (s OHLCV) Natr(timePeriod int) ([]float64, int) {
+	s.mustValidate("High", "Low", "Close")
+	return Natr(s.High, s.Low, s.Close, timePeriod, nil)
+}
+
+// Obv calls Obv with the Close and Volume columns of s.
+func (s OHLCV) Obv() ([]float64, int) {
+	s.mustValidate("Close", "Volume")
+	return Obv(s.Close, s.Volume, nil)
+}
+
+// PlusDi calls PlusDi with the High, Low and Close columns of s.
+func (s OHLCV) PlusDi(timePeriod int) ([]float64, int) {
+	s.mustValidate("High", "Low", "Close")
+	return PlusDi(s.High, s.Low, s.Close, timePeriod, nil)
+}
+
+// PlusDm calls PlusDm with the High and Low columns of s.
+func (s OHLCV) PlusDm(timePeriod int) ([]float64, int) {
+	s.mustValidate("High", "Low")
+	return PlusDm(s.High, s.Low, timePeriod, nil)
+}
+
+// Sar calls Sar with the High and Low columns of s.
+func (s OHLCV) Sar(acceleration, maximum float64) ([]float64, int) {
+	s.mustValidate("High", "Low")
+	return Sar(s.High, s.Low, acceleration, maximum, nil)
+}
+
+// SarExt calls SarExt with the High and Low columns of s.
+func (s OHLCV) SarExt(startValue, offsetOnReverse, accelerationInitLong, accelerationLong, accelerationMaxLong, accelerationInitShort, accelerationShort, accelerationMaxShort float64) ([]float64, int) {
+	s.mustValidate("High", "Low")
+	return SarExt(s.High, s.Low, startValue, offsetOnReverse, accelerationInitLong, accelerationLong, accelerationMaxLong, accelerationInitShort, accelerationShort, accelerationMaxShort, nil)
+}
+
+// Stoch calls Stoch with the High, Low and Close columns of s.
+func (s OHLCV) Stoch(fastKPeriod, slowKPeriod, slowKMAType, slowDPeriod, slowDMAType int) ([]float64, []float64, int) {
+	s.mustValidate("High", "Low", "Close")
+	return Stoch(s.High, s.Low, s.Close, fastKPeriod, slowKPeriod, slowKMAType, slowDPeriod, slowDMAType, nil, nil)
+}
+
+// Stochf calls Stochf with the High, Low and Close columns of s.
+func (s OHLCV) Stochf(fastKPeriod, fastDPeriod, fastDMAType int) ([]float64, []float64, int) {
+	s.mustValidate("High", "Low", "Close")
+	return Stochf(s.High, s.Low, s.Close, fastKPeriod, fastDPeriod, fastDMAType, nil, nil)
+}
+
+// Trange calls Trange with the High, Low and Close columns of s.
+func (s OHLCV) Trange() ([]float64, int) {
+	s.mustValidate("High", "Low", "Close")
+	return Trange(s.High, s.Low, s.Close, nil)
+}
+
+// TypPrice calls TypPrice with the High, Low and Close columns of s.
+func (s OHLCV) TypPrice() ([]float64, int) {
+	s.mustValidate("High", "Low", "Close")
+	return TypPrice(s.High, s.Low, s.Close, nil)
+}
+
+// UltOsc calls UltOsc with the High, Low and Close columns of s.
+func (s OHLCV) UltOsc(timePeriod1, timePeriod2, timePeriod3 int) ([]float64, int) {
+	s.mustValidate("High", "Low", "Close")
+	return UltOsc(s.High, s.Low, s.Close, timePeriod1, timePeriod2, timePeriod3, nil)
+}
+
+// WclPrice calls WclPrice with the High, Low and Close columns of s.
+func (s OHLCV) WclPrice() ([]float64, int) {
+	s.mustValidate("High", "Low", "Close")
+	return WclPrice(s.High, s.Low, s.Close, nil)
+}
+
+// Willr calls Willr with the High, Low and Close columns of s.
+func (s OHLCV) Willr(timePeriod int) ([]float64, int) {
+	s.mustValidate("High", "Low", "Close")
+	return Willr(s.High, s.Low, s.Close, timePeriod, nil)
+}
@@ -0,0 +1,343 @@
+package talib
+
+// Acos calls Acos with the values of s, and returns the outputs indexed by the times of s.
+func (s Series) Acos() Series {
+	outReal, begIdx := Acos(s.Values, nil)
+	return s.Indexed(outReal, begIdx)
+}
+
+// Apo calls Apo with the values of s, and returns the outputs indexed by the times of s.
+func (s Series) Apo(fastPeriod, slowPeriod, mAType int) Series {
+	outReal, begIdx := Apo(s.Values, fastPeriod, slowPeriod, mAType, nil)
+	return s.Indexed(outReal, begIdx)
+}
+
+// Asin calls Asin with the values of s, and returns the outputs indexed by the times of s.
+func (s Series) Asin() Series {
+	outReal, begIdx := Asin(s.Values, nil)
+	return s.Indexed(outReal, begIdx)
+}
+
+// Atan calls Atan with the values of s, and returns the outputs indexed by the times of s.
+func (s Series) Atan() Series {
+	outReal, begIdx := Atan(s.Values, nil)
+	return s.Indexed(outReal, begIdx)
+}
+
+// BBands calls BBands with the values of s, and returns the outputs indexed by the times of s.
+func (s Series) BBands(timePeriod int, nbDevUp, nbDevDn float64, mAType int) (Series, Series, Series) {
+	outRealUpperBand, outRealMiddleBand, outRealLowerBand, begIdx := BBands(s.Values, timePeriod, nbDevUp, nbDevDn, mAType, nil, nil, nil)
+	return s.Indexed(outRealUpperBand, begIdx), s.Indexed(outRealMiddleBand, begIdx), s.Indexed(outRealLowerBand, begIdx)
+}
+
+// Ceil calls Ceil with the values of s, and returns the outputs indexed by the times of s.
+func (s Series) Ceil() Series {
+	outReal, begIdx := Ceil(s.Values, nil)
+	return s.Indexed(outReal, begIdx)
+}
+
+// Cmo calls Cmo with the values of s, and returns the outputs indexed by the times of s.
+func (s Series) Cmo(timePeriod int) Series {
+	outReal, begIdx := Cmo(s.Values, timePeriod, nil)
+	return s.Indexed(outReal, begIdx)
+}
+
+// Cos calls Cos with the values of s, and returns the outputs indexed by the times of s.
+func (s Series) Cos() Series {
+	outReal, begIdx := Cos(s.Values, nil)
+	return s.Indexed(outReal, begIdx)
+}
+
+// Cosh calls Cosh with the values of s, and returns the outputs indexed by the times of s.
+func (s Series) Cosh() Series {
+	outReal, begIdx := Cosh(s.Values, nil)
+	return s.Indexed(outReal, begIdx)
+}
+
+// Dema calls Dema with the values of s, and returns the outputs indexed by the times of s.
+func (s Series) Dema(timePeriod int) Series {
+	outReal, begIdx := Dema(s.Values, timePeriod, nil)
+	return s.Indexed(outReal, begIdx)
+}
+
+// Ema calls Ema with the values of s, and returns the outputs indexed by the times of s.
+func (s Series) Ema(timePeriod int) Series {
+	outReal, begIdx := Ema(s.Values, timePeriod, nil)
+	return s.Indexed(outReal, begIdx)
+}
+
+// Exp calls Exp with the values of s, and returns the outputs indexed by the times of s.
+func (s Series) Exp() Series {
+	outReal, begIdx := Exp(s.Values, nil)
+	return s.Indexed(outReal, begIdx)
+}
+
+// Floor calls Floor with the values of s, and returns the outputs indexed by the times of s.
+func (s Series) Floor() Series {
+	outReal, begIdx := Floor(s.Values, nil)
+	return s.Indexed(outReal, begIdx)
+}
+
+// HtDcPeriod calls HtDcPeriod with the values of s, and returns the outputs indexed by the times of s.
+func (s Series) HtDcPeriod() Series {
+	outReal, begIdx := HtDcPeriod(s.Values, nil)
+	return s.Indexed(outReal, begIdx)
+}
+
+// HtDcPhase calls HtDcPhase with the values of s, and returns the outputs indexed by the times of s.
+func (s Series) HtDcPhase() Series {
+	outReal, begIdx := HtDcPhase(s.Values, nil)
+	return s.Indexed(outReal, begIdx)
+}
+
+// HtPhasor calls HtPhasor with the values of s, and returns the outputs indexed by the times of s.
+func (s Series) HtPhasor() (Series, Series) {
+	outInPhase, outQuadrature, begIdx := HtPhasor(s.Values, nil, nil)
+	return s.Indexed(outInPhase, begIdx), s.Indexed(outQuadrature, begIdx)
+}
+
+// HtSine calls HtSine with the values of s, and returns the outputs indexed by the times of s.
+func (s Series) HtSine() (Series, Series) {
+	outSine, outLeadSine, begIdx := HtSine(s.Values, nil, nil)
+	return s.Indexed(outSine, begIdx), s.Indexed(outLeadSine, begIdx)
+}
+
+// HtTrendLine calls HtTrendLine with the values of s, and returns the outputs indexed by the times of s.
+func (s Series) HtTrendLine() Series {
+	outReal, begIdx := HtTrendLine(s.Values, nil)
+	return s.Indexed(outReal, begIdx)
+}
+
+// Kama calls Kama with the values of s, and returns the outputs indexed by the times of s.
+func (s Series) Kama(timePeriod int) Series {
+	outReal, begIdx := Kama(s.Values, timePeriod, nil)
+	return s.Indexed(outReal, begIdx)
+}
+
+// LinearReg calls LinearReg with the values of s, and returns the outputs indexed by the times of s.
+func (s Series) LinearReg(timePeriod int) Series {
+	outReal, begIdx := LinearReg(s.Values, timePeriod, nil)
+	return s.Indexed(outReal, begIdx)
+}
+
+// LinearRegAngle calls LinearRegAngle with the values of s, and returns the outputs indexed by the times of s.
+func (s Series) LinearRegAngle(timePeriod int) Series {
+	outReal, begIdx := LinearRegAngle(s.Values, timePeriod, nil)
+	return s.Indexed(outReal, begIdx)
+}
+
+// LinearRegIntercept calls LinearRegIntercept with the values of s, and returns the outputs indexed by the times of s.
+func (s Series) LinearRegIntercept(timePeriod int) Series {
+	outReal, begIdx := LinearRegIntercept(s.Values, timePeriod, nil)
+	return s.Indexed(outReal, begIdx)
+}
+
+// LinearRegSlope calls LinearRegSlope with the values of s, and returns the outputs indexed by the times of s.
+func (s Series) LinearRegSlope(timePeriod int) Series {
+	outReal, begIdx := LinearRegSlope(s.Values, timePeriod, nil)
+	return s.Indexed(outReal, begIdx)
+}
+
+// Ln calls Ln with the values of s, and returns the outputs indexed by the times of s.
+func (s Series) Ln() Series {
+	outReal, begIdx := Ln(s.Values, nil)
+	return s.Indexed(outReal, begIdx)
+}
+
+// Log10 calls Log10 with the values of s, and returns the outputs indexed by the times of s.
+func (s Series) Log10() Series {
+	outReal, begIdx := Log10(s.Values, nil)
+	return s.Indexed(outReal, begIdx)
+}
+
+// Ma calls Ma with the values of s, and returns the outputs indexed by the times of s.
+func (s Series) Ma(timePeriod, mAType int) Series {
+	outReal, begIdx := Ma(s.Values, timePeriod, mAType, nil)
+	return s.Indexed(outReal, begIdx)
+}
+
+// Macd calls Macd with the values of s, and returns the outputs indexed by the times of s.
+func (s Series) Macd(fastPeriod, slowPeriod, signalPeriod int) (Series, Series, Series) {
+	outMACD, outMACDSignal, outMACDHist, begIdx := Macd(s.Values, fastPeriod, slowPeriod, signalPeriod, nil, nil, nil)
+	return s.Indexed(outMACD, begIdx), s.Indexed(outMACDSignal, begIdx), s.Indexed(outMACDHist, begIdx)
+}
+
+// MacdExt calls MacdExt with the values of s, and returns the outputs indexed by the times of s.
+func (s Series) MacdExt(fastPeriod, fastMAType, slowPeriod, slowMAType, signalPeriod, signalMAType int) (Series, Series, Series) {
+	outMACD, outMACDSignal, outMACDHist, begIdx := MacdExt(s.Values, fastPeriod, fastMAType, slowPeriod, slowMAType, signalPeriod, signalMAType, nil, nil, nil)
+	return s.Indexed(outMACD, begIdx), s.Indexed(outMACDSignal, begIdx), s.Indexed(outMACDHist, begIdx)
+}
+
+// MacdFix calls MacdFix with the values of s, and returns the outputs indexed by the times of s.
+func (s Series) MacdFix(signalPeriod int) (Series, Series, Series) {
+	outMACD, outMACDSignal, outMACDHist, begIdx := MacdFix(s.Values, signalPeriod, nil, nil, nil)
+	return s.Indexed(outMACD, begIdx), s.Indexed(outMACDSignal, begIdx), s.Indexed(outMACDHist, begIdx)
+}
+
+// Mama calls Mama with the values of s, and returns the outputs indexed by the times of s.
+func (s Series) Mama(fastLimit, slowLimit float64) (Series, Series) {
+	outMAMA, outFAMA, begIdx := Mama(s.Values, fastLimit, slowLimit, nil, nil)
+	return s.Indexed(outMAMA, begIdx), s.Indexed(outFAMA, begIdx)
+}
+
+// Max calls Max with the values of s, and returns the outputs indexed by the times of s.
+func (s Series) Max(timePeriod int) Series {
+	outReal, begIdx := Max(s.Values, timePeriod, nil)
+	return s.Indexed(outReal, begIdx)
+}
+
+// MidPoint calls MidPoint with the values of s, and returns the outputs indexed by the times of s.
+func (s Series) MidPoint(timePeriod int) Series {
+	outReal, begIdx := MidPoint(s.Values, timePeriod, nil)
+	return s.Indexed(outReal, begIdx)
+}
+
+// Min calls Min with the values of s, and returns the outputs indexed by the times of s.
+func (s Series) Min(timePeriod int) Series {
+	outReal, begIdx := Min(s.Values, timePeriod, nil)
+	return s.Indexed(outReal, begIdx)
+}
+
+// MinMax calls MinMax with the values of s, and returns the outputs indexed by the times of s.
+func (s Series) MinMax(timePeriod int) (Series, Series) {
+	outMin, outMax, begIdx := MinMax(s.Values, timePeriod, nil, nil)
+	return s.Indexed(outMin, begIdx), s.Indexed(outMax, begIdx)
+}
+
+// Mom calls Mom with the values of s, and returns the outputs indexed by the times of s.
+func (s Series) Mom(timePeriod int) Series {
+	outReal, begIdx := Mom(s.Values, timePeriod, nil)
+	return s.Indexed(outReal, begIdx)
+}
+
+// Ppo calls Ppo with the values of s, and returns the outputs indexed by the times of s.
+func (s Series) Ppo(fastPeriod, slowPeriod, mAType int) Series {
+	outReal, begIdx := Ppo(s.Values, fastPeriod, slowPeriod, mAType, nil)
+	return s.Indexed(outReal, begIdx)
+}
+
+// Roc calls Roc with the values of s, and returns the outputs indexed by the times of s.
+func (s Series) Roc(timePeriod int) Series {
+	outReal, begIdx := Roc(s.Values, timePeriod, nil)
+	return s.Indexed(outReal, begIdx)
+}
+
+// Rocp calls Rocp with the values of s, and returns the outputs indexed by the times of s.
+func (s Series) Rocp(timePeriod int) Series {
+	outReal, begIdx := Rocp(s.Values, timePeriod, nil)
+	return s.Indexed(outReal, begIdx)
+}
+
+// Rocr calls Rocr with the values of s, and returns the outputs indexed by the times of s.
+func (s Series) Rocr(timePeriod int) Series {
+	outReal, begIdx := Rocr(s.Values, timePeriod, nil)
+	return s.Indexed(outReal, begIdx)
+}
+
+// Rocr100 calls Rocr100 with the values of s, and returns the outputs indexed by the times of s.
+func (s Series) Rocr100(timePeriod int) Series {
+	outReal, begIdx := Rocr100(s.Values, timePeriod, nil)
+	return s.Indexed(outReal, begIdx)
+}
+
+// Rsi calls Rsi with the values of s, and returns the outputs indexed by the times of s.
+func (s Series) Rsi(timePeriod int) Series {
+	outReal, begIdx := Rsi(s.Values, timePeriod, nil)
+	return s.Indexed(outReal, begIdx)
+}
+
+// Sin calls Sin with the values of s, and returns the outputs indexed by the times of s.
+func (s Series) Sin() Series {
+	outReal, begIdx := Sin(s.Values, nil)
+	return s.Indexed(outReal, begIdx)
+}
+
+// Sinh calls Sinh with the values of s, and returns the outputs indexed by the times of s.
+func (s Series) Sinh() Series {
+	outReal, begIdx := Sinh(s.Values, nil)
+	return s.Indexed(outReal, begIdx)
+}
+
+// Sma calls Sma with the values of s, and returns the outputs indexed by the times of s.
+func (s Series) Sma(timePeriod int) Series {
+	outReal, begIdx := Sma(s.Values, timePeriod, nil)
+	return s.Indexed(outReal, begIdx)
+}
+
+// Sqrt calls Sqrt with the values of s, and returns the outputs indexed by the times of s.
+func (s Series) Sqrt() Series {
+	outReal, begIdx := Sqrt(s.Values, nil)
+	return s.Indexed(outReal, begIdx)
+}
+
+// StdDev calls StdDev with the values of s, and returns the outputs indexed by the times of s.
+func (s Series) StdDev(timePeriod int, nbDev float64) Series {
+	outReal, begIdx := StdDev(s.Values, timePeriod, nbDev, nil)
+	return s.Indexed(outReal, begIdx)
+}
+
+// StochRsi calls StochRsi with the values of s, and returns the outputs indexed by the times of s.
+func (s Series) StochRsi(timePeriod, fastKPeriod, fastDPeriod, fastDMAType int) (Series, Series) {
+	outFastK, outFastD, begIdx := StochRsi(s.Values, timePeriod, fastKPeriod, fastDPeriod, fastDMAType, nil, nil)
+	return s.Indexed(outFastK, begIdx), s.Indexed(outFastD, begIdx)
+}
+
+// Sum calls Sum with the values of s, and returns the outputs indexed by the times of s.
+func (s Series) Sum(timePeriod int) Series {
+	outReal, begIdx := Sum(s.Values, timePeriod, nil)
+	return s.Indexed(outReal, begIdx)
+}
+
+// T3 calls T3 with the values of s, and returns the outputs indexed by the times of s.
+func (s Series) T3(timePeriod int, vFactor float64) Series {
+	outReal, begIdx := T3(s.Values, timePeriod, vFactor, nil)
+	return s.Indexed(outReal, begIdx)
+}
+
+// Tan calls Tan with the values of s, and returns the outputs indexed by the times of s.
+func (s Series) Tan() Series {
+	outReal, begIdx := Tan(s.Values, nil)
+	return s.Indexed(outReal, begIdx)
+}
+
+// Tanh calls Tanh with the values of s, and returns the outputs indexed by the times of s.
+func (s Series) Tanh() Series {
+	outReal, begIdx := Tanh(s.Values, nil)
+	return s.Indexed(outReal, begIdx)
+}
+
+// Tema calls Tema with the values of s, and returns the outputs indexed by the times of s.
+func (s Series) Tema(timePeriod int) Series {
+	outReal, begIdx := Tema(s.Values, timePeriod, nil)
+	return s.Indexed(outReal, begIdx)
+}
+
+// TriMa calls TriMa with the values of s, and returns the outputs indexed by the times of s.
+func (s Series) TriMa(timePeriod int) Series {
+	outReal, begIdx := TriMa(s.Values, timePeriod, nil)
+	return s.Indexed(outReal, begIdx)
+}
+
+// Trix calls Trix with the values of s, and returns the outputs indexed by the times of s.
+func (s Series) Trix(timePeriod int) Series {
+	outReal, begIdx := Trix(s.Values, timePeriod, nil)
+	return s.Indexed(outReal, begIdx)
+}
+
+// Tsf calls Tsf with the values of s, and returns the outputs indexed by the times of s.
+func (s Series) Tsf(timePeriod int) Series {
+	outReal, begIdx := Tsf(s.Values, timePeriod, nil)
+	return s.Indexed(outReal, begIdx)
+}
+
+// Var calls Var with the values of s, and returns the outputs indexed by the times of s.
+func (s Series) Var(timePeriod int, nbDev float64) Series {
+	outReal, begIdx := Var(s.Values, timePeriod, nbDev, nil)
+	return s.Indexed(outReal, begIdx)
+}
+
+// Wma calls Wma with the values of s, and returns the outputs indexed by the times of s.
+func (s Series) Wma(timePeriod int) Series {
+	outReal, begIdx := Wma(s.Values, timePeriod, nil)
+	return s.Indexed(outReal, begIdx)
+}
@@ -0,0 +1,90 @@
+package talib
+
+import "math"
+
+// IchimokuLines holds the lines of the Ichimoku Kinko Hyo indicator.
+//
+// Element i of each line is the value plotted at bar i, with NaN where the line has no value. The BegIdx fields are the
+// index of the first value of each line.
+type IchimokuLines struct {
+	// Tenkan (the conversion line) is the midpoint of the highest high and lowest low over the tenkan period.
+	Tenkan []float64
+	// Kijun (the base line) is the midpoint of the highest high and lowest low over the kijun period.
+	Kijun []float64
+	// SenkouA (leading span A) is the average of Tenkan and Kijun, plotted displacement bars ahead. It has
+	// displacement more elements than the input, the last of which form the cloud beyond the last bar.
+	SenkouA []float64
+	// SenkouB (leading span B) is the midpoint of the highest high and lowest low over the senkou B period, plotted
+	// displacement bars ahead. It is the same length as SenkouA.
+	SenkouB []float64
+	// Chikou (the lagging span) is the close, plotted displacement bars behind. Its last displacement elements are
+	// NaN, and ChikouEndIdx is the index after its last value.
+	Chikou []float64
+
+	TenkanBegIdx, KijunBegIdx, SenkouABegIdx, SenkouBBegIdx int
+	ChikouEndIdx                                            int
+}
+
+// Ichimoku returns the lines of the Ichimoku Kinko Hyo indicator. Periods of 0 default to the usual 9, 26 and 52, and a
+// displacement of 0 defaults to 26. If any of them is negative, the returned lines are empty.
+func Ichimoku(high, low, close []float64, tenkanPeriod, kijunPeriod, senkouBPeriod, displacement int) IchimokuLines {
+	if tenkanPeriod < 0 || kijunPeriod < 0 || senkouBPeriod < 0 || displacement < 0 {
+		return IchimokuLines{}
+	}
+	if tenkanPeriod == 0 {
+		tenkanPeriod = 9
+	}
+	if kijunPeriod == 0 {
+		kijunPeriod = 26
+	}
+	if senkouBPeriod == 0 {
+		senkouBPeriod = 52
+	}
+	if displacement == 0 {
+		displacement = 26
+	}
+	n := len(high)
+	l := IchimokuLines{
+		TenkanBegIdx:  tenkanPeriod - 1,
+		KijunBegIdx:   kijunPeriod - 1,
+		SenkouABegIdx: kijunPeriod - 1 + displacement,
+		SenkouBBegIdx: senkouBPeriod - 1 + displacement,
+		ChikouEndIdx:  n - displacement,
+	}
+	if tenkanPeriod > kijunPeriod {
+		l.SenkouABegIdx = tenkanPeriod - 1 + displacement
+	}
+	if l.ChikouEndIdx < 0 {
+		l.ChikouEndIdx = 0
+	}
+
+	l.Tenkan, _ = MidPriceAligned(high, low, tenkanPeriod, nil)
+	l.Kijun, _ = MidPriceAligned(high, low, kijunPeriod, nil)
+	senkouB, _ := MidPriceAligned(high, low, senkouBPeriod, nil)
+
+	l.SenkouA = make([]float64, n+displacement)
+	l.SenkouB = make([]float64, n+displacement)
+	for i := 0; i < displacement; i++ {
+		l.SenkouA[i] = math.NaN()
+		l.SenkouB[i] = math.NaN()
+	}
+	for i := 0; i < n; i++ {
+		l.SenkouA[i+displacement] = (l.Tenkan[i] + l.Kijun[i]) / 2
+		l.SenkouB[i+displacement] = senkouB[i]
+	}
+
+	l.Chikou = make([]float64, n)
+	for i := range l.Chikou {
+		l.Chikou[i] = math.NaN()
+		if i+displacement < n {
+			l.Chikou[i] = close[i+displacement]
+		}
+	}
+	return l
+}
+
+// Ichimoku calls Ichimoku with the High, Low and Close columns of s.
+func (s OHLCV) Ichimoku(tenkanPeriod, kijunPeriod, senkouBPeriod, displacement int) IchimokuLines {
+	s.mustValidate("High", "Low", "Close")
+	return Ichimoku(s.High, s.Low, s.Close, tenkanPeriod, kijunPeriod, senkouBPeriod, displacement)
+}
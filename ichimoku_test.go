@@ -0,0 +1,56 @@
+package talib_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/phemmer/talib"
+)
+
+func TestIchimoku(t *testing.T) {
+	high := []float64{10, 11, 12, 13, 14, 15, 16, 17}
+	low := []float64{8, 9, 10, 11, 12, 13, 14, 15}
+	close := []float64{9, 10, 11, 12, 13, 14, 15, 16}
+
+	l := talib.Ichimoku(high, low, close, 2, 3, 4, 2)
+	if len(l.Tenkan) != 8 || len(l.SenkouA) != 10 || len(l.SenkouB) != 10 || len(l.Chikou) != 8 {
+		t.Fatalf("Unexpected lengths %#v.", l)
+	}
+	if l.TenkanBegIdx != 1 || l.KijunBegIdx != 2 || l.SenkouABegIdx != 4 || l.SenkouBBegIdx != 5 || l.ChikouEndIdx != 6 {
+		t.Errorf("Unexpected offsets %#v.", l)
+	}
+	// Tenkan at bar 1 is (11 + 8) / 2, Kijun at bar 2 is (12 + 8) / 2.
+	if !math.IsNaN(l.Tenkan[0]) || l.Tenkan[1] != 9.5 || !math.IsNaN(l.Kijun[1]) || l.Kijun[2] != 10 {
+		t.Errorf("Unexpected tenkan %#v kijun %#v.", l.Tenkan, l.Kijun)
+	}
+	// Senkou A at bar 4 is computed from bar 2, and Senkou B at bar 9 from bar 7.
+	if !math.IsNaN(l.SenkouA[3]) || l.SenkouA[4] != (10.5+10)/2 || l.SenkouB[9] != (17+12)/2.0 {
+		t.Errorf("Unexpected senkou %#v %#v.", l.SenkouA, l.SenkouB)
+	}
+	if l.Chikou[0] != 11 || l.Chikou[5] != 16 || !math.IsNaN(l.Chikou[6]) {
+		t.Errorf("Unexpected chikou %#v.", l.Chikou)
+	}
+}
+
+func TestIchimokuDefaults(t *testing.T) {
+	high := make([]float64, 100)
+	low := make([]float64, 100)
+	close := make([]float64, 100)
+	for i := range high {
+		high[i], low[i], close[i] = float64(i+2), float64(i), float64(i+1)
+	}
+
+	l := talib.Ichimoku(high, low, close, 0, 0, 0, 0)
+	if len(l.SenkouA) != 126 || len(l.SenkouB) != 126 {
+		t.Errorf("Expected a displacement of 26, got lengths %d and %d.", len(l.SenkouA), len(l.SenkouB))
+	}
+	if l.TenkanBegIdx != 8 || l.KijunBegIdx != 25 || l.SenkouABegIdx != 51 || l.SenkouBBegIdx != 77 || l.ChikouEndIdx != 74 {
+		t.Errorf("Unexpected offsets %#v.", l)
+	}
+
+	for _, p := range [][4]int{{-1, 26, 52, 26}, {9, -1, 52, 26}, {9, 26, -1, 26}, {9, 26, 52, -1}} {
+		if l := talib.Ichimoku(high, low, close, p[0], p[1], p[2], p[3]); l.Tenkan != nil || l.SenkouA != nil {
+			t.Errorf("Expected empty lines for %v, got %#v.", p, l)
+		}
+	}
+}
@@ -0,0 +1,129 @@
+package talib
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// jsonKey returns the value of the object field for the given column, matching names case-insensitively.
+func jsonKey(obj map[string]interface{}, name string, defaults []string) (interface{}, bool) {
+	names := defaults
+	if name != "" {
+		names = []string{name}
+	}
+	for _, n := range names {
+		for k, v := range obj {
+			if strings.EqualFold(k, n) {
+				return v, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// ReadJSONLines reads bars from a stream of JSON objects, typically one per line.
+//
+// Only the Columns, TimeFormat and Location options are used, with each column being the name of an object field. Times
+// may be strings or numbers, and prices may be numbers, numeric strings, or null (read as NaN). The set of columns is
+// determined by the first object.
+func ReadJSONLines(r io.Reader, opts ReadOptions) (OHLCV, error) {
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+
+	var s OHLCV
+	var present []bool
+	cols := []*[]float64{&s.Open, &s.High, &s.Low, &s.Close, &s.Volume}
+	names := opts.Columns.list()
+	for n := 1; ; n++ {
+		var obj map[string]interface{}
+		if err := dec.Decode(&obj); err == io.EOF {
+			break
+		} else if err != nil {
+			return OHLCV{}, err
+		}
+
+		if present == nil {
+			present = make([]bool, len(names))
+			for i, name := range names {
+				if name == "-" {
+					continue
+				}
+				_, present[i] = jsonKey(obj, name, defaultColumnNames[i])
+				if name != "" && !present[i] {
+					return OHLCV{}, fmt.Errorf("talib: column %q not found", name)
+				}
+			}
+		}
+
+		for i, name := range names {
+			if !present[i] {
+				continue
+			}
+			v, ok := jsonKey(obj, name, defaultColumnNames[i])
+			if !ok {
+				return OHLCV{}, fmt.Errorf("talib: object %d: missing field for column %s", n, defaultColumnNames[i][0])
+			}
+			if i == 0 {
+				t, err := opts.parseTime(fmt.Sprint(v))
+				if err != nil {
+					return OHLCV{}, fmt.Errorf("talib: object %d: %s", n, strings.TrimPrefix(err.Error(), "talib: "))
+				}
+				s.Time = append(s.Time, t)
+				continue
+			}
+			f := math.NaN()
+			if v != nil {
+				var err error
+				if f, err = strconv.ParseFloat(fmt.Sprint(v), 64); err != nil {
+					return OHLCV{}, fmt.Errorf("talib: object %d: invalid number %v", n, v)
+				}
+			}
+			*cols[i-1] = append(*cols[i-1], f)
+		}
+	}
+	return s, nil
+}
+
+// WriteJSONLines writes bars as one JSON object per line, with a field for each of the given columns.
+//
+// Only the columns of bars which are set are written. Times are written in RFC 3339 format. Values which are NaN, or
+// outside of the range of a column, are written as null.
+func WriteJSONLines(w io.Writer, bars OHLCV, columns ...Column) error {
+	if err := bars.Validate(); err != nil {
+		return err
+	}
+	fields := barFields(bars, columns)
+
+	var buf bytes.Buffer
+	for i := 0; i < bars.Len(); i++ {
+		buf.Reset()
+		buf.WriteByte('{')
+		if len(bars.Time) > 0 {
+			fmt.Fprintf(&buf, `"%s":"%s"`, defaultColumnNames[0][0], bars.Time[i].Format(time.RFC3339Nano))
+		}
+		for j, f := range fields {
+			if j > 0 || len(bars.Time) > 0 {
+				buf.WriteByte(',')
+			}
+			name, _ := json.Marshal(f.Name)
+			buf.Write(name)
+			buf.WriteByte(':')
+			if v := f.value(i); math.IsNaN(v) || math.IsInf(v, 0) {
+				buf.WriteString("null")
+			} else {
+				buf.WriteString(formatFloat(v))
+			}
+		}
+		buf.WriteString("}\n")
+		if _, err := w.Write(buf.Bytes()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
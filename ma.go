@@ -0,0 +1,222 @@
+package talib
+
+import "math"
+
+// MAType is a moving average type accepted by MovingAverage, and the functions implemented in Go which take a mAType,
+// such as Keltner. It may be one of the TA-Lib MAType constants (MAType_SMA to MAType_T3), or one of the types
+// implemented in Go below.
+//
+// The Go types are not supported by the TA-Lib functions which take an int mAType: Ma, Mavp, Apo, Ppo, BBands, MacdExt,
+// Stoch, Stochf and StochRsi, along with their Aligned and OHLCV variants. Those only accept the TA-Lib types.
+type MAType int
+
+// Moving average types implemented in Go, continuing from the TA-Lib types.
+const (
+	MAType_HMA      MAType = 9
+	MAType_ALMA     MAType = 10
+	MAType_ZLEMA    MAType = 11
+	MAType_SMMA     MAType = 12
+	MAType_MCGINLEY MAType = 13
+)
+
+// MovingAverage returns the moving average of the given type. The TA-Lib types are computed by Ma, and the others by the
+// function of the same name, with the default offset and sigma for Alma.
+func MovingAverage(real []float64, timePeriod int, mAType MAType, outReal []float64) ([]float64, int) {
+	switch mAType {
+	case MAType_HMA:
+		return Hma(real, timePeriod, outReal)
+	case MAType_ALMA:
+		return Alma(real, timePeriod, 0.85, 6, outReal)
+	case MAType_ZLEMA:
+		return Zlema(real, timePeriod, outReal)
+	case MAType_SMMA:
+		return Smma(real, timePeriod, outReal)
+	case MAType_MCGINLEY:
+		return McGinley(real, timePeriod, outReal)
+	}
+	return Ma(real, timePeriod, int(mAType), outReal)
+}
+
+// sma writes the simple moving average of in to out, starting with the average ending at in[n-1].
+func sma(in []float64, n int, out []float64) []float64 {
+	var sum float64
+	for i, v := range in {
+		sum += v
+		if i >= n {
+			sum -= in[i-n]
+		}
+		if i >= n-1 {
+			out[i-n+1] = sum / float64(n)
+		}
+	}
+	return out[:len(in)-n+1]
+}
+
+// wma writes the weighted moving average of in to out, starting with the average ending at in[n-1].
+func wma(in []float64, n int, out []float64) []float64 {
+	denom := float64(n*(n+1)) / 2
+	for i := n - 1; i < len(in); i++ {
+		var sum float64
+		for j := 0; j < n; j++ {
+			sum += in[i-n+1+j] * float64(j+1)
+		}
+		out[i-n+1] = sum / denom
+	}
+	return out[:len(in)-n+1]
+}
+
+// ema writes the exponential moving average of in with the given smoothing factor to out, seeded with the simple average
+// of the first n elements, and starting with the average ending at in[n-1].
+func ema(in []float64, n int, alpha float64, out []float64) []float64 {
+	var sum float64
+	for _, v := range in[:n] {
+		sum += v
+	}
+	out[0] = sum / float64(n)
+	for i := n; i < len(in); i++ {
+		out[i-n+1] = out[i-n] + alpha*(in[i]-out[i-n])
+	}
+	return out[:len(in)-n+1]
+}
+
+// Hma returns the Hull moving average: the weighted moving average over the square root of timePeriod of twice the
+// weighted moving average over half of timePeriod less the weighted moving average over timePeriod.
+func Hma(real []float64, timePeriod int, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(real))
+	}
+	half, sqrt := timePeriod/2, int(math.Round(math.Sqrt(float64(timePeriod))))
+	begIdx := timePeriod - 1 + sqrt - 1
+	if timePeriod < 2 || begIdx >= len(real) {
+		return outReal[:0], 0
+	}
+
+	wHalf := wma(real, half, make([]float64, len(real)))
+	wFull := wma(real, timePeriod, make([]float64, len(real)))
+	diff := make([]float64, len(wFull))
+	for i := range diff {
+		diff[i] = 2*wHalf[i+timePeriod-half] - wFull[i]
+	}
+	return wma(diff, sqrt, outReal), begIdx
+}
+
+// Alma returns the Arnaud Legoux moving average: the average over timePeriod weighted by a Gaussian curve centered at
+// offset (from 0 at the oldest to 1 at the newest element), with a width of timePeriod / sigma. The usual offset and
+// sigma are 0.85 and 6.
+func Alma(real []float64, timePeriod int, offset, sigma float64, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(real))
+	}
+	begIdx := timePeriod - 1
+	if timePeriod < 1 || begIdx >= len(real) {
+		return outReal[:0], 0
+	}
+
+	m := offset * float64(timePeriod-1)
+	s := float64(timePeriod) / sigma
+	weights := make([]float64, timePeriod)
+	var norm float64
+	for j := range weights {
+		d := float64(j) - m
+		weights[j] = math.Exp(-d * d / (2 * s * s))
+		norm += weights[j]
+	}
+	for i := begIdx; i < len(real); i++ {
+		var sum float64
+		for j, w := range weights {
+			sum += real[i-begIdx+j] * w
+		}
+		outReal[i-begIdx] = sum / norm
+	}
+	return outReal[:len(real)-begIdx], begIdx
+}
+
+// Zlema returns the zero lag exponential moving average: the exponential moving average of the input plus the change
+// over the last (timePeriod - 1) / 2 elements.
+func Zlema(real []float64, timePeriod int, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(real))
+	}
+	lag := (timePeriod - 1) / 2
+	begIdx := lag + timePeriod - 1
+	if timePeriod < 1 || begIdx >= len(real) {
+		return outReal[:0], 0
+	}
+
+	data := make([]float64, len(real)-lag)
+	for i := range data {
+		data[i] = 2*real[i+lag] - real[i]
+	}
+	return ema(data, timePeriod, 2/float64(timePeriod+1), outReal), begIdx
+}
+
+// Smma returns the smoothed (Wilder) moving average: the exponential moving average with a smoothing factor of
+// 1 / timePeriod, seeded with the simple average, as used by Rsi and Atr.
+func Smma(real []float64, timePeriod int, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(real))
+	}
+	begIdx := timePeriod - 1
+	if timePeriod < 1 || begIdx >= len(real) {
+		return outReal[:0], 0
+	}
+	return ema(real, timePeriod, 1/float64(timePeriod), outReal), begIdx
+}
+
+// McGinley returns the McGinley Dynamic, a moving average which adjusts its speed to that of the input. It is seeded
+// with the simple average of the first timePeriod elements.
+func McGinley(real []float64, timePeriod int, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(real))
+	}
+	begIdx := timePeriod - 1
+	if timePeriod < 1 || begIdx >= len(real) {
+		return outReal[:0], 0
+	}
+
+	var md float64
+	for _, v := range real[:timePeriod] {
+		md += v
+	}
+	md /= float64(timePeriod)
+	outReal[0] = md
+	for i := timePeriod; i < len(real); i++ {
+		md += (real[i] - md) / (float64(timePeriod) * math.Pow(real[i]/md, 4))
+		outReal[i-begIdx] = md
+	}
+	return outReal[:len(real)-begIdx], begIdx
+}
+
+// Vwma returns the volume weighted moving average over timePeriod. It is NaN where there is no volume.
+func Vwma(real, volume []float64, timePeriod int, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(real))
+	}
+	begIdx := timePeriod - 1
+	if timePeriod < 1 || begIdx >= len(real) {
+		return outReal[:0], 0
+	}
+
+	// The terms are kept so that outReal may be the same slice as real.
+	pvs, vs := make([]float64, timePeriod), make([]float64, timePeriod)
+	var pv, v float64
+	for i := range real {
+		k := i % timePeriod
+		pv += real[i]*volume[i] - pvs[k]
+		v += volume[i] - vs[k]
+		pvs[k], vs[k] = real[i]*volume[i], volume[i]
+		if i >= begIdx {
+			outReal[i-begIdx] = math.NaN()
+			if v > 0 {
+				outReal[i-begIdx] = pv / v
+			}
+		}
+	}
+	return outReal[:len(real)-begIdx], begIdx
+}
+
+// Vwma calls Vwma with the Close and Volume columns of s.
+func (s OHLCV) Vwma(timePeriod int) ([]float64, int) {
+	s.mustValidate("Close", "Volume")
+	return Vwma(s.Close, s.Volume, timePeriod, nil)
+}
@@ -0,0 +1,71 @@
+package talib_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/phemmer/talib"
+)
+
+func TestMovingAverageConstant(t *testing.T) {
+	in := []float64{5, 5, 5, 5, 5, 5, 5, 5, 5, 5}
+	for _, mAType := range []talib.MAType{talib.MAType_HMA, talib.MAType_ALMA, talib.MAType_ZLEMA, talib.MAType_SMMA, talib.MAType_MCGINLEY} {
+		out, begIdx := talib.MovingAverage(in, 4, mAType, nil)
+		if len(out) != len(in)-begIdx {
+			t.Errorf("MAType %d: expected %d outputs got %d.", mAType, len(in)-begIdx, len(out))
+		}
+		for _, v := range out {
+			if math.Abs(v-5) > 1e-9 {
+				t.Errorf("MAType %d: expected 5 got %v.", mAType, v)
+			}
+		}
+	}
+}
+
+func TestHmaZlemaLinear(t *testing.T) {
+	in := make([]float64, 12)
+	for i := range in {
+		in[i] = float64(i)
+	}
+	// Both averages have no lag for a linear input.
+	hma, begIdx := talib.Hma(in, 4, nil)
+	if begIdx != 4 {
+		t.Errorf("Expected begIdx 4 got %d.", begIdx)
+	}
+	for i, v := range hma {
+		if math.Abs(v-in[i+begIdx]) > 1e-9 {
+			t.Errorf("Expected %v got %v.", in[i+begIdx], v)
+		}
+	}
+	zlema, begIdx := talib.Zlema(in, 5, nil)
+	if begIdx != 6 {
+		t.Errorf("Expected begIdx 6 got %d.", begIdx)
+	}
+	for i, v := range zlema {
+		if math.Abs(v-in[i+begIdx]) > 1e-9 {
+			t.Errorf("Expected %v got %v.", in[i+begIdx], v)
+		}
+	}
+}
+
+func TestSmma(t *testing.T) {
+	out, begIdx := talib.Smma([]float64{1, 2, 3, 7, 4}, 3, nil)
+	expected := []float64{2, 11.0 / 3, 34.0 / 9}
+	if begIdx != 2 || !reflect.DeepEqual(expected, out) {
+		t.Errorf("Expected %#v got %#v.", expected, out)
+	}
+}
+
+func TestVwma(t *testing.T) {
+	out, begIdx := talib.Vwma([]float64{10, 20, 30}, []float64{1, 3, 1}, 2, nil)
+	expected := []float64{17.5, 22.5}
+	if begIdx != 1 || !reflect.DeepEqual(expected, out) {
+		t.Errorf("Expected %#v got %#v.", expected, out)
+	}
+
+	s := talib.OHLCV{Close: []float64{10, 20, 30}, Volume: []float64{1, 3, 1}}
+	if out, begIdx := s.Vwma(2); begIdx != 1 || !reflect.DeepEqual(expected, out) {
+		t.Errorf("Expected %#v got %#v.", expected, out)
+	}
+}
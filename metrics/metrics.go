@@ -0,0 +1,226 @@
+/*
+Package metrics computes performance and risk statistics of equity curves and returns.
+
+Functions taking returns expect simple per-period returns, such as those produced by Returns. Functions taking equity
+expect the value of a portfolio at the end of each period.
+*/
+package metrics
+
+import "math"
+
+// Config holds the parameters used to annualize statistics.
+type Config struct {
+	// PeriodsPerYear is the number of periods in a year, e.g. 252 for daily bars of a stock, or 52 for weekly bars.
+	// If 0, 252 is used.
+	PeriodsPerYear float64
+	// RiskFree is the annual risk-free rate, e.g. 0.02 for 2%.
+	RiskFree float64
+}
+
+func (c Config) periods() float64 {
+	if c.PeriodsPerYear <= 0 {
+		return 252
+	}
+	return c.PeriodsPerYear
+}
+
+// Returns returns the simple return of each period of an equity curve. The result has one fewer element than equity.
+func Returns(equity []float64) []float64 {
+	if len(equity) < 2 {
+		return nil
+	}
+	out := make([]float64, len(equity)-1)
+	for i := 1; i < len(equity); i++ {
+		out[i-1] = equity[i]/equity[i-1] - 1
+	}
+	return out
+}
+
+func mean(v []float64) float64 {
+	var sum float64
+	for _, x := range v {
+		sum += x
+	}
+	return sum / float64(len(v))
+}
+
+// stdDev returns the sample standard deviation.
+func stdDev(v []float64) float64 {
+	if len(v) < 2 {
+		return math.NaN()
+	}
+	m := mean(v)
+	var sum float64
+	for _, x := range v {
+		sum += (x - m) * (x - m)
+	}
+	return math.Sqrt(sum / float64(len(v)-1))
+}
+
+func (c Config) excess(returns []float64) []float64 {
+	rf := c.RiskFree / c.periods()
+	out := make([]float64, len(returns))
+	for i, r := range returns {
+		out[i] = r - rf
+	}
+	return out
+}
+
+// Volatility returns the annualized standard deviation of returns.
+func Volatility(returns []float64, cfg Config) float64 {
+	return stdDev(returns) * math.Sqrt(cfg.periods())
+}
+
+// Sharpe returns the annualized Sharpe ratio of returns.
+func Sharpe(returns []float64, cfg Config) float64 {
+	ex := cfg.excess(returns)
+	return mean(ex) / stdDev(ex) * math.Sqrt(cfg.periods())
+}
+
+// Sortino returns the annualized Sortino ratio of returns, which only penalizes returns below the risk-free rate.
+func Sortino(returns []float64, cfg Config) float64 {
+	ex := cfg.excess(returns)
+	var sum float64
+	for _, r := range ex {
+		if r < 0 {
+			sum += r * r
+		}
+	}
+	downside := math.Sqrt(sum / float64(len(ex)))
+	return mean(ex) / downside * math.Sqrt(cfg.periods())
+}
+
+// CAGR returns the compound annual growth rate of an equity curve.
+func CAGR(equity []float64, cfg Config) float64 {
+	if len(equity) < 2 {
+		return math.NaN()
+	}
+	years := float64(len(equity)-1) / cfg.periods()
+	return math.Pow(equity[len(equity)-1]/equity[0], 1/years) - 1
+}
+
+// drawdowns returns the drawdown of each period of an equity curve, as a positive fraction of the preceding peak.
+func drawdowns(equity []float64) []float64 {
+	out := make([]float64, len(equity))
+	peak := math.Inf(-1)
+	for i, e := range equity {
+		peak = math.Max(peak, e)
+		out[i] = 1 - e/peak
+	}
+	return out
+}
+
+// MaxDrawdown returns the largest drawdown of an equity curve as a positive fraction of the preceding peak, and the
+// largest number of periods spent below a preceding peak.
+func MaxDrawdown(equity []float64) (drawdown float64, duration int) {
+	var n int
+	for _, dd := range drawdowns(equity) {
+		drawdown = math.Max(drawdown, dd)
+		if dd > 0 {
+			n++
+			if n > duration {
+				duration = n
+			}
+		} else {
+			n = 0
+		}
+	}
+	return drawdown, duration
+}
+
+// Calmar returns the ratio of the CAGR to the maximum drawdown of an equity curve.
+func Calmar(equity []float64, cfg Config) float64 {
+	dd, _ := MaxDrawdown(equity)
+	return CAGR(equity, cfg) / dd
+}
+
+// UlcerIndex returns the Ulcer index of an equity curve, the root mean square of the percentage drawdowns.
+func UlcerIndex(equity []float64) float64 {
+	var sum float64
+	dds := drawdowns(equity)
+	for _, dd := range dds {
+		sum += (dd * 100) * (dd * 100)
+	}
+	return math.Sqrt(sum / float64(len(dds)))
+}
+
+// WinRate returns the fraction of trades with a positive profit.
+func WinRate(pnl []float64) float64 {
+	var wins int
+	for _, p := range pnl {
+		if p > 0 {
+			wins++
+		}
+	}
+	return float64(wins) / float64(len(pnl))
+}
+
+// ProfitFactor returns the ratio of the gross profit to the gross loss of trades.
+func ProfitFactor(pnl []float64) float64 {
+	var profit, loss float64
+	for _, p := range pnl {
+		if p > 0 {
+			profit += p
+		} else {
+			loss -= p
+		}
+	}
+	return profit / loss
+}
+
+// Exposure returns the fraction of periods with a non-zero position.
+func Exposure(position []float64) float64 {
+	var n int
+	for _, p := range position {
+		if p != 0 {
+			n++
+		}
+	}
+	return float64(n) / float64(len(position))
+}
+
+// Report is a summary of the performance of an equity curve.
+type Report struct {
+	TotalReturn         float64
+	CAGR                float64
+	Volatility          float64
+	Sharpe              float64
+	Sortino             float64
+	Calmar              float64
+	MaxDrawdown         float64
+	MaxDrawdownDuration int
+	UlcerIndex          float64
+	// Trades, WinRate and ProfitFactor are only set if trade profits are given.
+	Trades       int
+	WinRate      float64
+	ProfitFactor float64
+	// Exposure is only set if positions are given.
+	Exposure float64
+}
+
+// Compute returns a report for an equity curve. position (the position held in each period) and pnl (the profit or
+// loss of each trade) are optional, and may be nil.
+func Compute(equity, position, pnl []float64, cfg Config) Report {
+	returns := Returns(equity)
+	r := Report{
+		CAGR:       CAGR(equity, cfg),
+		Volatility: Volatility(returns, cfg),
+		Sharpe:     Sharpe(returns, cfg),
+		Sortino:    Sortino(returns, cfg),
+		Calmar:     Calmar(equity, cfg),
+		UlcerIndex: UlcerIndex(equity),
+		Trades:     len(pnl),
+	}
+	if len(equity) > 0 {
+		r.TotalReturn = equity[len(equity)-1]/equity[0] - 1
+	}
+	r.MaxDrawdown, r.MaxDrawdownDuration = MaxDrawdown(equity)
+	if len(pnl) > 0 {
+		r.WinRate = WinRate(pnl)
+		r.ProfitFactor = ProfitFactor(pnl)
+	}
+	if len(position) > 0 {
+		r.Exposure = Exposure(position)
+	}
+	return r
+}
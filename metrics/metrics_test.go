@@ -0,0 +1,60 @@
+package metrics_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/phemmer/talib/metrics"
+)
+
+func near(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+func TestReturns(t *testing.T) {
+	out := metrics.Returns([]float64{100, 110, 99})
+	if len(out) != 2 || !near(out[0], 0.1) || !near(out[1], -0.1) {
+		t.Errorf("Expected [0.1 -0.1] got %v.", out)
+	}
+}
+
+func TestMaxDrawdown(t *testing.T) {
+	dd, duration := metrics.MaxDrawdown([]float64{100, 120, 90, 100, 130, 117, 130})
+	if !near(dd, 0.25) || duration != 2 {
+		t.Errorf("Expected 0.25, 2 got %v, %v.", dd, duration)
+	}
+}
+
+func TestSharpe(t *testing.T) {
+	returns := []float64{0.01, -0.005, 0.02, 0.0, 0.015}
+	cfg := metrics.Config{PeriodsPerYear: 12, RiskFree: 0.012}
+	// mean excess 0.007, sample stddev of excess sqrt(0.00043/4)
+	expected := 0.007 / math.Sqrt(0.00043/4) * math.Sqrt(12)
+	if s := metrics.Sharpe(returns, cfg); !near(s, expected) {
+		t.Errorf("Expected %v got %v.", expected, s)
+	}
+}
+
+func TestCAGR(t *testing.T) {
+	// Doubling over 2 years of monthly data.
+	equity := make([]float64, 25)
+	for i := range equity {
+		equity[i] = 100 * math.Pow(2, float64(i)/24)
+	}
+	if c := metrics.CAGR(equity, metrics.Config{PeriodsPerYear: 12}); !near(c, math.Sqrt2-1) {
+		t.Errorf("Expected %v got %v.", math.Sqrt2-1, c)
+	}
+}
+
+func TestTradeStats(t *testing.T) {
+	pnl := []float64{10, -5, 20, -5}
+	if w := metrics.WinRate(pnl); w != 0.5 {
+		t.Errorf("Expected 0.5 got %v.", w)
+	}
+	if pf := metrics.ProfitFactor(pnl); pf != 3 {
+		t.Errorf("Expected 3 got %v.", pf)
+	}
+	if e := metrics.Exposure([]float64{0, 1, 1, 0}); e != 0.5 {
+		t.Errorf("Expected 0.5 got %v.", e)
+	}
+}
@@ -0,0 +1,279 @@
+package talib
+
+import "math"
+
+// Tsi returns the True Strength Index: 100 times the double smoothed momentum divided by the double smoothed absolute
+// momentum. The momentum is smoothed by an exponential moving average over longPeriod, and then over shortPeriod. The
+// usual periods are 25 and 13.
+func Tsi(real []float64, longPeriod, shortPeriod int, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(real))
+	}
+	begIdx := longPeriod + shortPeriod - 1
+	if longPeriod < 1 || shortPeriod < 1 || begIdx >= len(real) {
+		return outReal[:0], 0
+	}
+
+	mom := make([]float64, len(real)-1)
+	absMom := make([]float64, len(mom))
+	for i := range mom {
+		mom[i] = real[i+1] - real[i]
+		absMom[i] = math.Abs(mom[i])
+	}
+	smooth := func(in []float64) []float64 {
+		e := ema(in, longPeriod, 2/float64(longPeriod+1), make([]float64, len(in)))
+		return ema(e, shortPeriod, 2/float64(shortPeriod+1), e)
+	}
+	num, den := smooth(mom), smooth(absMom)
+	for i := range num {
+		outReal[i] = math.NaN()
+		if den[i] != 0 {
+			outReal[i] = 100 * num[i] / den[i]
+		}
+	}
+	return outReal[:len(num)], begIdx
+}
+
+// Kst returns the Know Sure Thing, and its signal line. The KST is the weighted sum of four rates of change
+// (100 * (real / real n periods ago - 1)) over rocPeriod1-4, each smoothed by a simple moving average over smaPeriod1-4,
+// with weights of 1 to 4. The signal line is the simple moving average of the KST over signalPeriod. The usual periods
+// are 10, 15, 20 and 30 for the rates of change, 10, 10, 10 and 15 for the averages, and 9 for the signal line.
+func Kst(real []float64, rocPeriod1, rocPeriod2, rocPeriod3, rocPeriod4, smaPeriod1, smaPeriod2, smaPeriod3, smaPeriod4, signalPeriod int, outKst []float64, outKstSignal []float64) ([]float64, []float64, int) {
+	if outKst == nil {
+		outKst = make([]float64, len(real))
+	}
+	if outKstSignal == nil {
+		outKstSignal = make([]float64, len(real))
+	}
+	rocs := [4]int{rocPeriod1, rocPeriod2, rocPeriod3, rocPeriod4}
+	smas := [4]int{smaPeriod1, smaPeriod2, smaPeriod3, smaPeriod4}
+	kstBegIdx := 0
+	for k := range rocs {
+		if rocs[k] < 1 || smas[k] < 1 {
+			return outKst[:0], outKstSignal[:0], 0
+		}
+		if b := rocs[k] + smas[k] - 1; b > kstBegIdx {
+			kstBegIdx = b
+		}
+	}
+	begIdx := kstBegIdx + signalPeriod - 1
+	if signalPeriod < 1 || begIdx >= len(real) {
+		return outKst[:0], outKstSignal[:0], 0
+	}
+
+	kst := make([]float64, len(real)-kstBegIdx)
+	for k := range rocs {
+		roc := make([]float64, len(real)-rocs[k])
+		for i := range roc {
+			roc[i] = 100 * (real[i+rocs[k]]/real[i] - 1)
+		}
+		avg := sma(roc, smas[k], make([]float64, len(roc)))
+		// avg[0] corresponds to real[rocs[k]+smas[k]-1].
+		offset := kstBegIdx - (rocs[k] + smas[k] - 1)
+		for i := range kst {
+			kst[i] += float64(k+1) * avg[i+offset]
+		}
+	}
+	signal := sma(kst, signalPeriod, outKstSignal)
+	copy(outKst, kst[signalPeriod-1:])
+	return outKst[:len(signal)], signal, begIdx
+}
+
+// ao returns the simple moving average of the median price over fastPeriod less that over slowPeriod.
+func ao(high, low []float64, fastPeriod, slowPeriod int) []float64 {
+	median := make([]float64, len(high))
+	for i := range median {
+		median[i] = (high[i] + low[i]) / 2
+	}
+	fast := sma(median, fastPeriod, make([]float64, len(median)))
+	slow := sma(median, slowPeriod, make([]float64, len(median)))
+	out := make([]float64, len(slow))
+	for i := range out {
+		out[i] = fast[i+slowPeriod-fastPeriod] - slow[i]
+	}
+	return out
+}
+
+// Ao returns Bill Williams' Awesome Oscillator: the simple moving average of the median price ((high + low) / 2) over
+// fastPeriod less that over slowPeriod. The usual periods are 5 and 34.
+func Ao(high, low []float64, fastPeriod, slowPeriod int, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(high))
+	}
+	begIdx := slowPeriod - 1
+	if fastPeriod < 1 || slowPeriod < fastPeriod || begIdx >= len(high) {
+		return outReal[:0], 0
+	}
+	return outReal[:copy(outReal, ao(high, low, fastPeriod, slowPeriod))], begIdx
+}
+
+// Ac returns Bill Williams' Accelerator Oscillator: the Awesome Oscillator less its simple moving average over
+// signalPeriod. The usual periods are 5, 34 and 5.
+func Ac(high, low []float64, fastPeriod, slowPeriod, signalPeriod int, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(high))
+	}
+	begIdx := slowPeriod + signalPeriod - 2
+	if fastPeriod < 1 || slowPeriod < fastPeriod || signalPeriod < 1 || begIdx >= len(high) {
+		return outReal[:0], 0
+	}
+
+	a := ao(high, low, fastPeriod, slowPeriod)
+	avg := sma(a, signalPeriod, make([]float64, len(a)))
+	for i := range avg {
+		outReal[i] = a[i+signalPeriod-1] - avg[i]
+	}
+	return outReal[:len(avg)], begIdx
+}
+
+// Fisher returns Ehlers' Fisher Transform of the position of the median price ((high + low) / 2) within its range over
+// timePeriod, and its trigger line, which is the previous value of the transform.
+func Fisher(high, low []float64, timePeriod int, outFisher []float64, outFisherTrigger []float64) ([]float64, []float64, int) {
+	if outFisher == nil {
+		outFisher = make([]float64, len(high))
+	}
+	if outFisherTrigger == nil {
+		outFisherTrigger = make([]float64, len(high))
+	}
+	begIdx := timePeriod
+	if timePeriod < 1 || begIdx >= len(high) {
+		return outFisher[:0], outFisherTrigger[:0], 0
+	}
+
+	median := make([]float64, len(high))
+	for i := range median {
+		median[i] = (high[i] + low[i]) / 2
+	}
+	var value, fisher float64
+	for i := timePeriod - 1; i < len(high); i++ {
+		hi, lo := math.Inf(-1), math.Inf(1)
+		for _, m := range median[i-timePeriod+1 : i+1] {
+			hi, lo = math.Max(hi, m), math.Min(lo, m)
+		}
+		pos := 0.5
+		if hi > lo {
+			pos = (median[i] - lo) / (hi - lo)
+		}
+		value = 0.66*(pos-0.5) + 0.67*value
+		value = math.Max(-0.999, math.Min(0.999, value))
+
+		prev := fisher
+		fisher = 0.5*math.Log((1+value)/(1-value)) + 0.5*fisher
+		if i >= begIdx {
+			outFisher[i-begIdx] = fisher
+			outFisherTrigger[i-begIdx] = prev
+		}
+	}
+	n := len(high) - begIdx
+	return outFisher[:n], outFisherTrigger[:n], begIdx
+}
+
+// ConnorsRsi returns the Connors RSI: the average of the Rsi of real over rsiPeriod, the Rsi of the streak of
+// consecutive rises (positive) or falls (negative) over streakPeriod, and the percentage of the previous rankPeriod
+// one period rates of change which are below the current one. The usual periods are 3, 2 and 100.
+func ConnorsRsi(real []float64, rsiPeriod, streakPeriod, rankPeriod int, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(real))
+	}
+	if rankPeriod < 1 || rankPeriod+1 >= len(real) {
+		return outReal[:0], 0
+	}
+
+	streak := make([]float64, len(real))
+	for i := 1; i < len(real); i++ {
+		switch {
+		case real[i] > real[i-1]:
+			streak[i] = math.Max(streak[i-1], 0) + 1
+		case real[i] < real[i-1]:
+			streak[i] = math.Min(streak[i-1], 0) - 1
+		}
+	}
+	rsi, rsiBegIdx := Rsi(real, rsiPeriod, nil)
+	streakRsi, streakBegIdx := Rsi(streak, streakPeriod, nil)
+	if len(rsi) == 0 || len(streakRsi) == 0 {
+		return outReal[:0], 0
+	}
+
+	begIdx := rankPeriod + 1
+	if rsiBegIdx > begIdx {
+		begIdx = rsiBegIdx
+	}
+	if streakBegIdx > begIdx {
+		begIdx = streakBegIdx
+	}
+	roc := func(i int) float64 { return real[i]/real[i-1] - 1 }
+	for i := begIdx; i < len(real); i++ {
+		r := roc(i)
+		var below int
+		for j := i - rankPeriod; j < i; j++ {
+			if roc(j) < r {
+				below++
+			}
+		}
+		rank := 100 * float64(below) / float64(rankPeriod)
+		outReal[i-begIdx] = (rsi[i-rsiBegIdx] + streakRsi[i-streakBegIdx] + rank) / 3
+	}
+	return outReal[:len(real)-begIdx], begIdx
+}
+
+// stochSmooth returns the stochastic %K over n of in, smoothed by half towards the previous value. Where the range is
+// 0, the previous %K is used.
+func stochSmooth(in []float64, n int) []float64 {
+	out := make([]float64, len(in)-n+1)
+	var k float64
+	for i := n - 1; i < len(in); i++ {
+		hi, lo := math.Inf(-1), math.Inf(1)
+		for _, v := range in[i-n+1 : i+1] {
+			hi, lo = math.Max(hi, v), math.Min(lo, v)
+		}
+		if hi > lo {
+			k = 100 * (in[i] - lo) / (hi - lo)
+		}
+		if i == n-1 {
+			out[0] = k
+		} else {
+			out[i-n+1] = out[i-n] + 0.5*(k-out[i-n])
+		}
+	}
+	return out
+}
+
+// Stc returns the Schaff Trend Cycle: a stochastic of a stochastic of the difference between the exponential moving
+// averages of real over fastPeriod and slowPeriod, each over cyclePeriod and smoothed. It ranges from 0 to 100. The
+// usual periods are 23, 50 and 10.
+func Stc(real []float64, fastPeriod, slowPeriod, cyclePeriod int, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(real))
+	}
+	begIdx := slowPeriod + 2*cyclePeriod - 3
+	if fastPeriod < 1 || slowPeriod < fastPeriod || cyclePeriod < 1 || begIdx >= len(real) {
+		return outReal[:0], 0
+	}
+
+	fast := ema(real, fastPeriod, 2/float64(fastPeriod+1), make([]float64, len(real)))
+	slow := ema(real, slowPeriod, 2/float64(slowPeriod+1), make([]float64, len(real)))
+	macd := make([]float64, len(slow))
+	for i := range macd {
+		macd[i] = fast[i+slowPeriod-fastPeriod] - slow[i]
+	}
+	stc := stochSmooth(stochSmooth(macd, cyclePeriod), cyclePeriod)
+	return outReal[:copy(outReal, stc)], begIdx
+}
+
+// Ao calls Ao with the High and Low columns of s.
+func (s OHLCV) Ao(fastPeriod, slowPeriod int) ([]float64, int) {
+	s.mustValidate("High", "Low")
+	return Ao(s.High, s.Low, fastPeriod, slowPeriod, nil)
+}
+
+// Ac calls Ac with the High and Low columns of s.
+func (s OHLCV) Ac(fastPeriod, slowPeriod, signalPeriod int) ([]float64, int) {
+	s.mustValidate("High", "Low")
+	return Ac(s.High, s.Low, fastPeriod, slowPeriod, signalPeriod, nil)
+}
+
+// Fisher calls Fisher with the High and Low columns of s.
+func (s OHLCV) Fisher(timePeriod int) ([]float64, []float64, int) {
+	s.mustValidate("High", "Low")
+	return Fisher(s.High, s.Low, timePeriod, nil, nil)
+}
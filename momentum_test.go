@@ -0,0 +1,90 @@
+package talib_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/phemmer/talib"
+)
+
+func TestTsi(t *testing.T) {
+	var real []float64
+	for i := 1; i <= 20; i++ {
+		real = append(real, float64(i))
+	}
+	out, begIdx := talib.Tsi(real, 3, 2, nil)
+	expected := make([]float64, 16)
+	for i := range expected {
+		expected[i] = 100
+	}
+	if begIdx != 4 || !closeTo(expected, out) {
+		t.Errorf("Expected %#v got %#v.", expected, out)
+	}
+}
+
+func TestKst(t *testing.T) {
+	var real []float64
+	for i := 0; i < 8; i++ {
+		real = append(real, math.Pow(2, float64(i)))
+	}
+	// Rates of change over 1-4: 100, 300, 700, 1500.
+	kst, signal, begIdx := talib.Kst(real, 1, 2, 3, 4, 1, 1, 1, 2, 2, nil, nil)
+	expected := []float64{8800, 8800}
+	if begIdx != 6 || !closeTo(expected, kst) || !closeTo(expected, signal) {
+		t.Errorf("Expected %#v got %#v, %#v.", expected, kst, signal)
+	}
+}
+
+func TestAoAc(t *testing.T) {
+	high := []float64{2, 4, 8, 6}
+	low := []float64{0, 2, 4, 4}
+	// Median: 1, 3, 6, 5
+	ao, begIdx := talib.Ao(high, low, 1, 2, nil)
+	expected := []float64{1, 1.5, -0.5}
+	if begIdx != 1 || !closeTo(expected, ao) {
+		t.Errorf("Expected %#v got %#v.", expected, ao)
+	}
+
+	ac, begIdx := talib.Ac(high, low, 1, 2, 2, nil)
+	expected = []float64{0.25, -1}
+	if begIdx != 2 || !closeTo(expected, ac) {
+		t.Errorf("Expected %#v got %#v.", expected, ac)
+	}
+}
+
+func TestFisher(t *testing.T) {
+	// Median: 1, 2, 3, 2, 1, 2, 3, 4, 3.5, 5
+	high := []float64{2, 3, 4, 3, 2, 3, 4, 5, 4.5, 6}
+	low := []float64{0, 1, 2, 1, 0, 1, 2, 3, 2.5, 4}
+	fisher, trigger, begIdx := talib.Fisher(high, low, 3, nil, nil)
+	expectedFisher := []float64{0.0620805, -0.396141, -0.137984, 0.319675, 0.819352, 0.818281, 1.08601}
+	expectedTrigger := []float64{0.342828, 0.0620805, -0.396141, -0.137984, 0.319675, 0.819352, 0.818281}
+	if begIdx != 3 || !within(expectedFisher, fisher, 1e-5) || !within(expectedTrigger, trigger, 1e-5) {
+		t.Errorf("Expected %#v, %#v got %#v, %#v.", expectedFisher, expectedTrigger, fisher, trigger)
+	}
+}
+
+func TestConnorsRsi(t *testing.T) {
+	var real []float64
+	for i := 1; i <= 20; i++ {
+		real = append(real, float64(i))
+	}
+	// Both Rsis are 100, and every rate of change is below the previous ones.
+	out, begIdx := talib.ConnorsRsi(real, 3, 2, 5, nil)
+	expected := make([]float64, 14)
+	for i := range expected {
+		expected[i] = 200.0 / 3
+	}
+	if begIdx != 6 || !closeTo(expected, out) {
+		t.Errorf("Expected %#v got %#v.", expected, out)
+	}
+}
+
+func TestStc(t *testing.T) {
+	real := []float64{10, 11, 12, 11, 10, 9, 10, 12, 13, 12, 11, 12, 14, 15, 13, 12, 11, 13}
+	out, begIdx := talib.Stc(real, 2, 4, 3, nil)
+	expected := []float64{100, 100, 50, 25, 40.9351, 70.4676, 85.2338, 42.6169, 21.3084, 10.6542, 55.3271}
+	if begIdx != 7 || !within(expected, out, 1e-5) {
+		t.Errorf("Expected %#v got %#v.", expected, out)
+	}
+}
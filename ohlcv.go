@@ -0,0 +1,91 @@
+package talib
+
+import (
+	"fmt"
+	"time"
+)
+
+// OHLCV is a series of price bars stored as parallel columns.
+//
+// Each element of the columns describes the same bar. Time is optional, as is any column not used by the functions
+// being called (e.g. Open and Volume are not needed for Atr). Columns which are set must all be of the same length.
+//
+// OHLCV has a method for each function in the package whose inputs are made up of open, high, low, close and volume
+// data. The methods pass the appropriate columns to the function, and allocate new output slices. They panic if the
+// columns are not the same length, or if a column used by the function is missing.
+type OHLCV struct {
+	Time   []time.Time
+	Open   []float64
+	High   []float64
+	Low    []float64
+	Close  []float64
+	Volume []float64
+}
+
+// Len returns the number of bars in the series.
+func (s OHLCV) Len() int {
+	for _, n := range s.lens() {
+		if n != 0 {
+			return n
+		}
+	}
+	return 0
+}
+
+// ohlcvColumns are the names of the columns, in the order returned by lens.
+var ohlcvColumns = []string{"Close", "Open", "High", "Low", "Volume", "Time"}
+
+func (s OHLCV) lens() []int {
+	return []int{len(s.Close), len(s.Open), len(s.High), len(s.Low), len(s.Volume), len(s.Time)}
+}
+
+// Validate checks that all the non-empty columns of the series are the same length.
+func (s OHLCV) Validate() error {
+	n := s.Len()
+	for i, l := range s.lens() {
+		if l != 0 && l != n {
+			return fmt.Errorf("talib: OHLCV column %s has length %d, expected %d", ohlcvColumns[i], l, n)
+		}
+	}
+	return nil
+}
+
+// require checks that the series is valid, and that the named columns (e.g. "Close") are not empty.
+func (s OHLCV) require(columns ...string) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	lens := s.lens()
+	for _, name := range columns {
+		for i, c := range ohlcvColumns {
+			if c == name && lens[i] == 0 {
+				return fmt.Errorf("talib: OHLCV column %s is missing", name)
+			}
+		}
+	}
+	return nil
+}
+
+// column returns the column with the given lower case name, e.g. "close".
+func (s OHLCV) column(name string) ([]float64, bool) {
+	switch name {
+	case "open":
+		return s.Open, true
+	case "high":
+		return s.High, true
+	case "low":
+		return s.Low, true
+	case "close":
+		return s.Close, true
+	case "volume":
+		return s.Volume, true
+	}
+	return nil, false
+}
+
+// mustValidate panics if the series is not valid, or if any of the named columns are missing.
+func (s OHLCV) mustValidate(columns ...string) {
+	if err := s.require(columns...); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,52 @@
+package talib_test
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/phemmer/talib"
+)
+
+func TestOHLCVValidate(t *testing.T) {
+	s := talib.OHLCV{
+		High:  []float64{2, 3, 4},
+		Low:   []float64{1, 2, 3},
+		Close: []float64{1.5, 2.5},
+	}
+	if err := s.Validate(); err == nil {
+		t.Errorf("Expected error for mismatched columns.")
+	}
+	s.Close = append(s.Close, 3.5)
+	if err := s.Validate(); err != nil {
+		t.Errorf("Unexpected error: %s", err)
+	}
+}
+
+func TestOHLCVAtr(t *testing.T) {
+	s := talib.OHLCV{
+		High:  []float64{10, 11, 12, 11, 13, 14},
+		Low:   []float64{9, 9.5, 10, 10, 11, 12},
+		Close: []float64{9.5, 10.5, 11, 10.5, 12.5, 13},
+	}
+	expected, expectedIdx := talib.Atr(s.High, s.Low, s.Close, 3, nil)
+	out, idx := s.Atr(3)
+	if !reflect.DeepEqual(expected, out) || expectedIdx != idx {
+		t.Errorf("Expected %#v, %d got %#v, %d.", expected, expectedIdx, out, idx)
+	}
+}
+
+func TestOHLCVMissingColumn(t *testing.T) {
+	s := talib.OHLCV{
+		High: []float64{10, 11, 12, 11},
+		Low:  []float64{9, 9.5, 10, 10},
+	}
+	defer func() {
+		err := recover()
+		if err == nil || !strings.Contains(fmt.Sprint(err), "Close") {
+			t.Errorf("Expected panic for missing Close column, got %v.", err)
+		}
+	}()
+	s.Atr(2)
+}
@@ -0,0 +1,267 @@
+/*
+Package optimize searches for the parameters of functions and strategies which maximize an objective.
+
+A search evaluates an Objective for each candidate set of parameters, concurrently across CPU cores, and returns the
+results sorted by score. E.g. to find the RSI period with the best backtest Sharpe ratio:
+
+	space := optimize.SpaceFor(talib.LookupFunction("RSI"))
+	results, err := optimize.GridSearch(ctx, space, func(p optimize.Params) (float64, error) {
+		res, err := backtest.Run(bars, &rsiStrategy{period: p.Int("timePeriod")}, cfg)
+		if err != nil {
+			return 0, err
+		}
+		return res.Metrics(metrics.Config{}).Sharpe, nil
+	}, optimize.Options{})
+
+WalkForward repeats a search over rolling or anchored in-sample windows, and tests the chosen parameters over the
+out-of-sample window following each, to check that they hold up on data they were not optimized for.
+*/
+package optimize
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand"
+	"runtime"
+	"sort"
+	"sync"
+
+	"github.com/phemmer/talib"
+)
+
+// Param is a dimension of a parameter space.
+type Param struct {
+	Name     string
+	Min, Max float64
+	// Step is the spacing of the values used by GridSearch. If 0, every integer is used for integer parameters with up to
+	// 20 values, and otherwise 10 evenly spaced values.
+	Step float64
+	// Integer restricts the parameter to integer values.
+	Integer bool
+}
+
+// Space is the set of parameters being searched.
+type Space []Param
+
+// Limits applied by SpaceFor to the ranges of function options, which are often much wider than is useful.
+const (
+	MaxPeriod = 200
+	MaxReal   = 10
+)
+
+// SpaceFor returns the space of the options of a function, using their valid ranges.
+//
+// Integer options are limited to MaxPeriod, and real options to ±MaxReal. The returned space may be modified to
+// narrow the ranges further, or to remove options which should not be searched.
+func SpaceFor(f *talib.FuncInfo) Space {
+	space := make(Space, len(f.Options))
+	for i, o := range f.Options {
+		p := Param{Name: o.Name, Min: o.Min, Max: o.Max, Integer: o.Type != talib.OptionReal}
+		if p.Integer {
+			p.Max = math.Min(p.Max, MaxPeriod)
+		} else {
+			p.Min = math.Max(p.Min, -MaxReal)
+			p.Max = math.Min(p.Max, MaxReal)
+		}
+		space[i] = p
+	}
+	return space
+}
+
+// Params is a set of parameter values, keyed by name.
+type Params map[string]float64
+
+// Int returns the named parameter as an int.
+func (p Params) Int(name string) int {
+	return int(math.Round(p[name]))
+}
+
+// Values returns the parameter values in the order of the space, such as for passing to talib.FuncInfo.Call.
+func (p Params) Values(space Space) []float64 {
+	v := make([]float64, len(space))
+	for i, s := range space {
+		v[i] = p[s.Name]
+	}
+	return v
+}
+
+// values returns the values of the parameter used by GridSearch.
+func (p Param) values() []float64 {
+	step := p.Step
+	if step <= 0 {
+		if p.Integer && p.Max-p.Min < 20 {
+			step = 1
+		} else {
+			step = (p.Max - p.Min) / 9
+		}
+	}
+	if step <= 0 {
+		return []float64{p.Min}
+	}
+
+	var out []float64
+	for i := 0; ; i++ {
+		v := p.Min + float64(i)*step
+		if v > p.Max+step*1e-9 {
+			break
+		}
+		if p.Integer {
+			v = math.Round(v)
+			if len(out) > 0 && out[len(out)-1] == v {
+				continue
+			}
+		}
+		out = append(out, v)
+	}
+	return out
+}
+
+// Grid returns every combination of the parameter values of the space.
+func Grid(space Space) []Params {
+	out := []Params{{}}
+	for _, p := range space {
+		values := p.values()
+		next := make([]Params, 0, len(out)*len(values))
+		for _, params := range out {
+			for _, v := range values {
+				np := make(Params, len(params)+1)
+				for k, pv := range params {
+					np[k] = pv
+				}
+				np[p.Name] = v
+				next = append(next, np)
+			}
+		}
+		out = next
+	}
+	return out
+}
+
+// Random returns n sets of parameter values chosen uniformly at random from the space. The same seed always produces
+// the same values.
+func Random(space Space, n int, seed int64) []Params {
+	rnd := rand.New(rand.NewSource(seed))
+	out := make([]Params, n)
+	for i := range out {
+		params := make(Params, len(space))
+		for _, p := range space {
+			if p.Integer {
+				params[p.Name] = math.Floor(p.Min + rnd.Float64()*(math.Floor(p.Max)-p.Min+1))
+			} else {
+				params[p.Name] = p.Min + rnd.Float64()*(p.Max-p.Min)
+			}
+		}
+		out[i] = params
+	}
+	return out
+}
+
+// Objective returns the score of a set of parameters. Higher scores are better.
+type Objective func(p Params) (float64, error)
+
+// Options controls how a search is run.
+type Options struct {
+	// Workers is the number of objectives evaluated concurrently. If 0, the number of CPUs is used.
+	Workers int
+}
+
+// Result is the outcome of evaluating the objective for a set of parameters.
+type Result struct {
+	Params Params
+	Score  float64
+	// Err is the error returned by the objective, if any.
+	Err error
+}
+
+// Evaluate evaluates the objective for each of the candidates, and returns the results sorted by descending score.
+// Results with an error or a NaN score are sorted last. Ties keep the order of the candidates, so the results do not
+// depend on the number of workers.
+//
+// If ctx is cancelled, Evaluate stops and returns the context's error.
+func Evaluate(ctx context.Context, candidates []Params, obj Objective, opts Options) ([]Result, error) {
+	ranked, err := rank(ctx, candidates, func(i int) (float64, error) { return obj(candidates[i]) }, opts)
+	if err != nil {
+		return nil, err
+	}
+	results := make([]Result, len(ranked))
+	for i, r := range ranked {
+		results[i] = r.Result
+	}
+	return results, nil
+}
+
+// rankedResult is a Result along with the index of its candidate.
+type rankedResult struct {
+	Result
+	index int
+}
+
+// rank implements Evaluate, passing the objective the index of each candidate rather than its parameters.
+func rank(ctx context.Context, candidates []Params, obj func(i int) (float64, error), opts Options) ([]rankedResult, error) {
+	workers := opts.Workers
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+
+	results := make([]rankedResult, len(candidates))
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				results[i] = rankedResult{evaluate(candidates[i], func(Params) (float64, error) { return obj(i) }), i}
+			}
+		}()
+	}
+
+	var err error
+feed:
+	for i := range candidates {
+		select {
+		case jobs <- i:
+		case <-ctx.Done():
+			err = ctx.Err()
+			break feed
+		}
+	}
+	close(jobs)
+	wg.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		a, b := results[i], results[j]
+		aOK, bOK := a.Err == nil && !math.IsNaN(a.Score), b.Err == nil && !math.IsNaN(b.Score)
+		if aOK != bOK {
+			return aOK
+		}
+		return a.Score > b.Score
+	})
+	return results, nil
+}
+
+func evaluate(p Params, obj Objective) (res Result) {
+	res.Params = p
+	defer func() {
+		if r := recover(); r != nil {
+			res.Err = fmt.Errorf("optimize: objective panicked: %v", r)
+		}
+	}()
+	res.Score, res.Err = obj(p)
+	return res
+}
+
+// GridSearch evaluates the objective for every combination of the parameter values of the space. See Evaluate.
+func GridSearch(ctx context.Context, space Space, obj Objective, opts Options) ([]Result, error) {
+	return Evaluate(ctx, Grid(space), obj, opts)
+}
+
+// RandomSearch evaluates the objective for n sets of parameter values chosen at random from the space. See Random
+// and Evaluate.
+func RandomSearch(ctx context.Context, space Space, n int, seed int64, obj Objective, opts Options) ([]Result, error) {
+	return Evaluate(ctx, Random(space, n, seed), obj, opts)
+}
@@ -0,0 +1,68 @@
+package optimize_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/phemmer/talib"
+	"github.com/phemmer/talib/optimize"
+)
+
+func TestGrid(t *testing.T) {
+	space := optimize.Space{
+		{Name: "period", Min: 2, Max: 4, Integer: true},
+		{Name: "dev", Min: 1, Max: 2, Step: 0.5},
+	}
+	grid := optimize.Grid(space)
+	if len(grid) != 9 {
+		t.Fatalf("Expected 9 combinations got %d.", len(grid))
+	}
+	if expected := (optimize.Params{"period": 4, "dev": 1.5}); !reflect.DeepEqual(expected, grid[7]) {
+		t.Errorf("Expected %#v got %#v.", expected, grid[7])
+	}
+}
+
+func TestRandomReproducible(t *testing.T) {
+	space := optimize.Space{{Name: "period", Min: 2, Max: 50, Integer: true}, {Name: "dev", Min: 0, Max: 3}}
+	a, b := optimize.Random(space, 20, 42), optimize.Random(space, 20, 42)
+	if !reflect.DeepEqual(a, b) {
+		t.Errorf("Expected the same values for the same seed.")
+	}
+	for _, p := range a {
+		if p["period"] < 2 || p["period"] > 50 || p["period"] != float64(p.Int("period")) || p["dev"] < 0 || p["dev"] > 3 {
+			t.Errorf("Value out of range %#v.", p)
+		}
+	}
+}
+
+func TestGridSearch(t *testing.T) {
+	space := optimize.Space{{Name: "x", Min: -5, Max: 5, Integer: true}}
+	results, err := optimize.GridSearch(context.Background(), space, func(p optimize.Params) (float64, error) {
+		x := p["x"]
+		if x == 5 {
+			return 0, errors.New("invalid")
+		}
+		return -(x - 2) * (x - 2), nil
+	}, optimize.Options{Workers: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 11 || results[0].Params["x"] != 2 || results[10].Err == nil {
+		t.Errorf("Unexpected results %#v.", results)
+	}
+}
+
+func TestSpaceFor(t *testing.T) {
+	space := optimize.SpaceFor(talib.LookupFunction("BBANDS"))
+	expected := optimize.Space{
+		{Name: "timePeriod", Min: 2, Max: optimize.MaxPeriod, Integer: true},
+		{Name: "nbDevUp", Min: -optimize.MaxReal, Max: optimize.MaxReal},
+		{Name: "nbDevDn", Min: -optimize.MaxReal, Max: optimize.MaxReal},
+		{Name: "mAType", Min: 0, Max: talib.MAType_T3, Integer: true},
+	}
+	if !reflect.DeepEqual(expected, space) {
+		t.Errorf("Expected %#v got %#v.", expected, space)
+	}
+}
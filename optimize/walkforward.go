@@ -0,0 +1,147 @@
+package optimize
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/phemmer/talib"
+	"github.com/phemmer/talib/backtest"
+	"github.com/phemmer/talib/metrics"
+)
+
+// Window is a range of bars, from Start up to but not including End.
+type Window struct {
+	Start, End int
+}
+
+// WindowObjective returns the performance of a set of parameters over a window of bars.
+type WindowObjective func(p Params, w Window) (metrics.Report, error)
+
+// WalkForwardOptions controls how a walk-forward analysis is run.
+type WalkForwardOptions struct {
+	Options
+	// InSample and OutOfSample are the number of bars in the in-sample (optimized) and out-of-sample (tested) windows
+	// of each fold.
+	InSample, OutOfSample int
+	// Step is the number of bars each fold moves forward by. If 0, OutOfSample is used, so that the out-of-sample
+	// windows are consecutive.
+	Step int
+	// Anchored keeps the start of every in-sample window at the first bar, so that the in-sample windows grow rather
+	// than roll forward.
+	Anchored bool
+	// Candidates returns the parameters evaluated in each in-sample window. If nil, Grid is used.
+	Candidates func(space Space) []Params
+	// Score returns the score being maximized. If nil, the Sharpe ratio is used.
+	Score func(r metrics.Report) float64
+}
+
+// Fold is the outcome of one step of a walk-forward analysis.
+type Fold struct {
+	InSample, OutOfSample Window
+	// Params are the parameters with the best in-sample score.
+	Params                            Params
+	InSampleScore, OutOfSampleScore   float64
+	InSampleReport, OutOfSampleReport metrics.Report
+}
+
+// Windows returns the in-sample and out-of-sample windows of a walk-forward analysis over n bars. Only folds whose
+// out-of-sample window fits entirely within the bars are returned.
+func (o WalkForwardOptions) Windows(n int) (inSample, outOfSample []Window) {
+	step := o.Step
+	if step <= 0 {
+		step = o.OutOfSample
+	}
+	if o.InSample <= 0 || o.OutOfSample <= 0 || step <= 0 {
+		return nil, nil
+	}
+	for start := 0; start+o.InSample+o.OutOfSample <= n; start += step {
+		is := Window{Start: start, End: start + o.InSample}
+		if o.Anchored {
+			is.Start = 0
+		}
+		inSample = append(inSample, is)
+		outOfSample = append(outOfSample, Window{Start: is.End, End: is.End + o.OutOfSample})
+	}
+	return inSample, outOfSample
+}
+
+// WalkForward runs a walk-forward analysis over n bars. For each fold, the parameters with the best score over the
+// in-sample window are chosen, and then evaluated over the following out-of-sample window.
+func WalkForward(ctx context.Context, n int, space Space, obj WindowObjective, opts WalkForwardOptions) ([]Fold, error) {
+	candidates := opts.Candidates
+	if candidates == nil {
+		candidates = Grid
+	}
+	score := opts.Score
+	if score == nil {
+		score = func(r metrics.Report) float64 { return r.Sharpe }
+	}
+
+	inSample, outOfSample := opts.Windows(n)
+	if len(inSample) == 0 {
+		return nil, fmt.Errorf("optimize: no walk-forward folds fit in %d bars", n)
+	}
+
+	folds := make([]Fold, len(inSample))
+	for i := range folds {
+		f := &folds[i]
+		f.InSample, f.OutOfSample = inSample[i], outOfSample[i]
+
+		params := candidates(space)
+		reports := make([]metrics.Report, len(params))
+		results, err := rank(ctx, params, func(j int) (float64, error) {
+			r, err := obj(params[j], f.InSample)
+			reports[j] = r
+			return score(r), err
+		}, opts.Options)
+		if err != nil {
+			return nil, err
+		}
+		if len(results) == 0 || results[0].Err != nil || math.IsNaN(results[0].Score) {
+			return nil, fmt.Errorf("optimize: no valid parameters for fold %d", i)
+		}
+		f.Params = results[0].Params
+		f.InSampleScore = results[0].Score
+		f.InSampleReport = reports[results[0].index]
+
+		if f.OutOfSampleReport, err = obj(f.Params, f.OutOfSample); err != nil {
+			return nil, err
+		}
+		f.OutOfSampleScore = score(f.OutOfSampleReport)
+	}
+	return folds, nil
+}
+
+// Backtest returns a WindowObjective which backtests the strategy created for each set of parameters.
+//
+// The backtest is run over the bars up to the end of the window, so that indicators are warmed up by the bars
+// preceding it, and the metrics are computed from the equity, positions and trades within the window.
+func Backtest(bars talib.OHLCV, strategy func(p Params) backtest.Strategy, cfg backtest.Config, mcfg metrics.Config) WindowObjective {
+	return func(p Params, w Window) (metrics.Report, error) {
+		res, err := backtest.Run(head(bars, w.End), strategy(p), cfg)
+		if err != nil {
+			return metrics.Report{}, err
+		}
+		var pnl []float64
+		for _, t := range res.Trades {
+			if t.EntryIndex >= w.Start {
+				pnl = append(pnl, t.PnL)
+			}
+		}
+		return metrics.Compute(res.Equity[w.Start:], res.Position[w.Start:], pnl, mcfg), nil
+	}
+}
+
+// head returns the first n bars.
+func head(bars talib.OHLCV, n int) talib.OHLCV {
+	for _, col := range []*[]float64{&bars.Open, &bars.High, &bars.Low, &bars.Close, &bars.Volume} {
+		if len(*col) > 0 {
+			*col = (*col)[:n]
+		}
+	}
+	if len(bars.Time) > 0 {
+		bars.Time = bars.Time[:n]
+	}
+	return bars
+}
@@ -0,0 +1,80 @@
+package optimize_test
+
+import (
+	"context"
+	"math"
+	"reflect"
+	"sync/atomic"
+	"testing"
+
+	"github.com/phemmer/talib/metrics"
+	"github.com/phemmer/talib/optimize"
+)
+
+func TestWalkForwardWindows(t *testing.T) {
+	opts := optimize.WalkForwardOptions{InSample: 50, OutOfSample: 20}
+	is, oos := opts.Windows(120)
+	expectedIS := []optimize.Window{{0, 50}, {20, 70}, {40, 90}}
+	expectedOOS := []optimize.Window{{50, 70}, {70, 90}, {90, 110}}
+	if !reflect.DeepEqual(expectedIS, is) || !reflect.DeepEqual(expectedOOS, oos) {
+		t.Errorf("Expected %#v %#v got %#v %#v.", expectedIS, expectedOOS, is, oos)
+	}
+
+	opts.Anchored = true
+	is, _ = opts.Windows(120)
+	expectedIS = []optimize.Window{{0, 50}, {0, 70}, {0, 90}}
+	if !reflect.DeepEqual(expectedIS, is) {
+		t.Errorf("Expected %#v got %#v.", expectedIS, is)
+	}
+}
+
+func TestWalkForward(t *testing.T) {
+	// The best x for a window is the end of the window divided by 10.
+	obj := func(p optimize.Params, w optimize.Window) (metrics.Report, error) {
+		d := p["x"] - float64(w.End/10)
+		return metrics.Report{Sharpe: -d * d}, nil
+	}
+	space := optimize.Space{{Name: "x", Min: 0, Max: 20, Step: 1, Integer: true}}
+	folds, err := optimize.WalkForward(context.Background(), 100, space, obj, optimize.WalkForwardOptions{InSample: 40, OutOfSample: 20})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(folds) != 3 {
+		t.Fatalf("Expected 3 folds got %d.", len(folds))
+	}
+	for i, f := range folds {
+		if f.Params.Int("x") != f.InSample.End/10 || f.InSampleScore != 0 || f.OutOfSampleScore != -4 {
+			t.Errorf("Unexpected fold %d %#v.", i, f)
+		}
+	}
+}
+
+func TestWalkForwardCalls(t *testing.T) {
+	var calls int64
+	obj := func(p optimize.Params, w optimize.Window) (metrics.Report, error) {
+		atomic.AddInt64(&calls, 1)
+		return metrics.Report{Sharpe: p["x"] + float64(w.Start)}, nil
+	}
+	space := optimize.Space{{Name: "x", Min: 0, Max: 4, Step: 1, Integer: true}}
+	folds, err := optimize.WalkForward(context.Background(), 60, space, obj, optimize.WalkForwardOptions{InSample: 40, OutOfSample: 20})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Each of the 5 candidates is evaluated in-sample, and then the best is evaluated out-of-sample.
+	if calls != 6 {
+		t.Errorf("Expected 6 objective calls got %d.", calls)
+	}
+	if f := folds[0]; f.InSampleReport.Sharpe != 4 || f.OutOfSampleReport.Sharpe != 44 {
+		t.Errorf("Unexpected fold %#v.", f)
+	}
+}
+
+func TestWalkForwardNaN(t *testing.T) {
+	obj := func(p optimize.Params, w optimize.Window) (metrics.Report, error) {
+		return metrics.Report{Sharpe: math.NaN()}, nil
+	}
+	space := optimize.Space{{Name: "x", Min: 0, Max: 4, Step: 1, Integer: true}}
+	if _, err := optimize.WalkForward(context.Background(), 60, space, obj, optimize.WalkForwardOptions{InSample: 40, OutOfSample: 20}); err == nil {
+		t.Errorf("Expected error for a fold with only NaN scores.")
+	}
+}
@@ -0,0 +1,209 @@
+package talib
+
+import (
+	"math"
+	"time"
+)
+
+// PivotMethod is a method of calculating pivot points.
+type PivotMethod int
+
+const (
+	// PivotClassic is the floor trader pivot: P = (H + L + C) / 3, with R1-R3 and S1-S3.
+	PivotClassic PivotMethod = iota
+	// PivotWoodie weights the open of the current period: P = (H + L + 2 * Open) / 4, with R1-R4 and S1-S4.
+	PivotWoodie
+	// PivotCamarilla places R1-R4 and S1-S4 at 1.1/12, 1.1/6, 1.1/4 and 1.1/2 of the range from the close.
+	PivotCamarilla
+	// PivotDemark chooses the pivot according to whether the period closed above or below its open, with R1 and S1.
+	PivotDemark
+	// PivotFibonacci places R1-R3 and S1-S3 at 0.382, 0.618 and 1 times the range from the classic pivot.
+	PivotFibonacci
+)
+
+// PivotPeriod is the period whose prices pivot points are calculated from.
+type PivotPeriod int
+
+const (
+	// PivotBar uses the previous bar, e.g. for daily bars.
+	PivotBar PivotPeriod = iota
+	// PivotSession uses the previous session.
+	PivotSession
+	// PivotWeek uses the previous week, of sessions grouped by the ISO week in which they start.
+	PivotWeek
+	// PivotMonth uses the previous month, of sessions grouped by the month in which they start.
+	PivotMonth
+)
+
+// PivotOptions controls how pivot points are calculated.
+type PivotOptions struct {
+	Method PivotMethod
+	Period PivotPeriod
+	// Session defines the sessions used to group bars for all periods except PivotBar. Bars outside of a session have
+	// no levels.
+	Session Session
+}
+
+// PivotLevels holds the pivot, resistance and support levels of each bar. Levels which are not defined by the method,
+// or for which there is no previous period, are NaN.
+type PivotLevels struct {
+	Pivot          []float64
+	R1, R2, R3, R4 []float64
+	S1, S2, S3, S4 []float64
+}
+
+// pivotPeriod holds the prices of a period.
+type pivotPeriod struct {
+	open, high, low, close float64
+}
+
+func (p *pivotPeriod) add(high, low, close float64) {
+	p.high = math.Max(p.high, high)
+	p.low = math.Min(p.low, low)
+	p.close = close
+}
+
+// levels returns the pivot, R1-R4 and S1-S4 calculated from the previous period, and the open of the current period.
+func (p pivotPeriod) levels(method PivotMethod, open float64) [9]float64 {
+	h, l, c := p.high, p.low, p.close
+	r := h - l
+	nan := math.NaN()
+	switch method {
+	case PivotWoodie:
+		pp := (h + l + 2*open) / 4
+		r3 := h + 2*(pp-l)
+		s3 := l - 2*(h-pp)
+		return [9]float64{pp, 2*pp - l, pp + r, r3, r3 + r, 2*pp - h, pp - r, s3, s3 - r}
+	case PivotCamarilla:
+		pp := (h + l + c) / 3
+		return [9]float64{pp, c + r*1.1/12, c + r*1.1/6, c + r*1.1/4, c + r*1.1/2, c - r*1.1/12, c - r*1.1/6, c - r*1.1/4, c - r*1.1/2}
+	case PivotDemark:
+		x := h + l + 2*c
+		if c < p.open {
+			x = h + 2*l + c
+		} else if c > p.open {
+			x = 2*h + l + c
+		}
+		return [9]float64{x / 4, x/2 - l, nan, nan, nan, x/2 - h, nan, nan, nan}
+	case PivotFibonacci:
+		pp := (h + l + c) / 3
+		return [9]float64{pp, pp + 0.382*r, pp + 0.618*r, pp + r, nan, pp - 0.382*r, pp - 0.618*r, pp - r, nan}
+	}
+	pp := (h + l + c) / 3
+	return [9]float64{pp, 2*pp - l, pp + r, h + 2*(pp-l), nan, 2*pp - h, pp - r, l - 2*(h-pp), nan}
+}
+
+// pivotKey returns a value identifying the period containing t, or ok false if t is not within a session.
+func (o PivotOptions) pivotKey(t time.Time) (key time.Time, ok bool) {
+	start, _, ok := o.Session.Bounds(t)
+	if !ok {
+		return key, false
+	}
+	switch o.Period {
+	case PivotWeek:
+		// Step back to the Monday of the week.
+		offset := (int(start.Weekday()) + 6) % 7
+		y, m, d := start.Date()
+		return time.Date(y, m, d-offset, 0, 0, 0, 0, start.Location()), true
+	case PivotMonth:
+		y, m, _ := start.Date()
+		return time.Date(y, m, 1, 0, 0, 0, 0, start.Location()), true
+	}
+	return start, true
+}
+
+// Pivots returns the pivot points of each bar, calculated from the open, high, low and close of the previous period.
+// times is only needed for periods other than PivotBar, and open only for the PivotWoodie and PivotDemark methods.
+func Pivots(times []time.Time, open, high, low, close []float64, opts PivotOptions) PivotLevels {
+	n := len(close)
+	lv := PivotLevels{}
+	outs := []*[]float64{&lv.Pivot, &lv.R1, &lv.R2, &lv.R3, &lv.R4, &lv.S1, &lv.S2, &lv.S3, &lv.S4}
+	for _, out := range outs {
+		*out = make([]float64, n)
+	}
+	openAt := func(i int) float64 {
+		if len(open) == 0 {
+			return math.NaN()
+		}
+		return open[i]
+	}
+
+	var prev, cur pivotPeriod
+	var hasPrev, hasCur bool
+	var curKey time.Time
+	for i := 0; i < n; i++ {
+		key, ok := time.Time{}, true
+		if opts.Period != PivotBar {
+			key, ok = opts.pivotKey(times[i])
+		}
+		if !ok {
+			for _, out := range outs {
+				(*out)[i] = math.NaN()
+			}
+			continue
+		}
+		if opts.Period == PivotBar || !hasCur || !key.Equal(curKey) {
+			prev, hasPrev = cur, hasCur
+			cur = pivotPeriod{open: openAt(i), high: math.Inf(-1), low: math.Inf(1)}
+			curKey, hasCur = key, true
+		}
+		cur.add(high[i], low[i], close[i])
+
+		levels := [9]float64{}
+		for j := range levels {
+			levels[j] = math.NaN()
+		}
+		if hasPrev {
+			levels = prev.levels(opts.Method, cur.open)
+		}
+		for j, out := range outs {
+			(*out)[i] = levels[j]
+		}
+	}
+	return lv
+}
+
+// Pivots calls Pivots with the columns of s.
+func (s OHLCV) Pivots(opts PivotOptions) PivotLevels {
+	columns := []string{"High", "Low", "Close"}
+	if opts.Period != PivotBar {
+		columns = append(columns, "Time")
+	}
+	if opts.Method == PivotWoodie || opts.Method == PivotDemark {
+		columns = append(columns, "Open")
+	}
+	s.mustValidate(columns...)
+	return Pivots(s.Time, s.Open, s.High, s.Low, s.Close, opts)
+}
+
+// FibRetracementRatios are the usual Fibonacci retracement ratios.
+var FibRetracementRatios = []float64{0.236, 0.382, 0.5, 0.618, 0.786}
+
+// FibExtensionRatios are the usual Fibonacci extension ratios.
+var FibExtensionRatios = []float64{0.618, 1, 1.272, 1.618, 2.618}
+
+// FibRetracement returns the levels which retrace the given ratios of the swing from one price to another, in the order
+// of the ratios. If no ratios are given, FibRetracementRatios are used.
+func FibRetracement(from, to float64, ratios ...float64) []float64 {
+	if len(ratios) == 0 {
+		ratios = FibRetracementRatios
+	}
+	levels := make([]float64, len(ratios))
+	for i, r := range ratios {
+		levels[i] = to - (to-from)*r
+	}
+	return levels
+}
+
+// FibExtension returns the levels which extend from the end of a retracement (c) by the given ratios of the swing
+// before it (a to b), in the order of the ratios. If no ratios are given, FibExtensionRatios are used.
+func FibExtension(a, b, c float64, ratios ...float64) []float64 {
+	if len(ratios) == 0 {
+		ratios = FibExtensionRatios
+	}
+	levels := make([]float64, len(ratios))
+	for i, r := range ratios {
+		levels[i] = c + (b-a)*r
+	}
+	return levels
+}
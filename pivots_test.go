@@ -0,0 +1,62 @@
+package talib_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/phemmer/talib"
+)
+
+func TestPivots(t *testing.T) {
+	high := []float64{12, 14}
+	low := []float64{6, 10}
+	close := []float64{9, 12}
+
+	lv := talib.Pivots(nil, nil, high, low, close, talib.PivotOptions{})
+	if !math.IsNaN(lv.Pivot[0]) {
+		t.Errorf("Expected NaN got %v.", lv.Pivot[0])
+	}
+	// P = 9, range = 6
+	got := []float64{lv.Pivot[1], lv.R1[1], lv.R2[1], lv.R3[1], lv.S1[1], lv.S2[1], lv.S3[1]}
+	expected := []float64{9, 12, 15, 18, 6, 3, 0}
+	if !reflect.DeepEqual(expected, got) || !math.IsNaN(lv.R4[1]) {
+		t.Errorf("Expected %#v got %#v.", expected, got)
+	}
+
+	lv = talib.Pivots(nil, nil, high, low, close, talib.PivotOptions{Method: talib.PivotFibonacci})
+	if math.Abs(lv.R1[1]-(9+0.382*6)) > 1e-9 || lv.S3[1] != 3 {
+		t.Errorf("Unexpected Fibonacci levels %#v.", lv)
+	}
+}
+
+func TestPivotsSession(t *testing.T) {
+	day := time.Date(2019, 3, 4, 0, 0, 0, 0, time.UTC)
+	times := []time.Time{
+		day.Add(10 * time.Hour),
+		day.Add(11 * time.Hour),
+		day.Add(34 * time.Hour),
+		day.Add(35 * time.Hour),
+	}
+	high := []float64{11, 12, 20, 21}
+	low := []float64{7, 6, 18, 19}
+	close := []float64{8, 9, 19, 20}
+	opts := talib.PivotOptions{Period: talib.PivotSession, Session: talib.Session{Start: 9 * time.Hour, End: 17 * time.Hour}}
+
+	lv := talib.Pivots(times, nil, high, low, close, opts)
+	if !math.IsNaN(lv.Pivot[1]) || lv.Pivot[2] != 9 || lv.Pivot[3] != 9 {
+		t.Errorf("Unexpected pivots %#v.", lv.Pivot)
+	}
+}
+
+func TestFibRetracement(t *testing.T) {
+	expected := []float64{150, 125}
+	if got := talib.FibRetracement(100, 200, 0.5, 0.75); !reflect.DeepEqual(expected, got) {
+		t.Errorf("Expected %#v got %#v.", expected, got)
+	}
+	expected = []float64{250, 300}
+	if got := talib.FibExtension(100, 200, 150, 1, 1.5); !reflect.DeepEqual(expected, got) {
+		t.Errorf("Expected %#v got %#v.", expected, got)
+	}
+}
@@ -0,0 +1,184 @@
+package talib
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// Trade is a single trade, as used by ResampleTrades.
+type Trade struct {
+	Time  time.Time
+	Price float64
+	Size  float64
+}
+
+// Session is the period of each day during which a market trades.
+//
+// The zero value is a 24 hour session starting at midnight UTC.
+type Session struct {
+	// Start is the offset from midnight at which the session starts.
+	Start time.Duration
+	// End is the offset from midnight at which the session ends. If End is not after Start, the session ends on the
+	// following day.
+	End time.Duration
+	// Location is the time zone that Start and End are in. If nil, UTC is used.
+	Location *time.Location
+}
+
+// Bounds returns the start and end of the session containing t. If t is not within a session, ok is false.
+func (s Session) Bounds(t time.Time) (start, end time.Time, ok bool) {
+	loc := s.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+	length := s.End - s.Start
+	if length <= 0 {
+		length += 24 * time.Hour
+	}
+
+	y, m, d := t.In(loc).Date()
+	// time.Date normalizes the nanoseconds, so this gives the wall clock time even across DST transitions.
+	start = time.Date(y, m, d, 0, 0, 0, int(s.Start), loc)
+	if start.After(t) {
+		d--
+		start = time.Date(y, m, d, 0, 0, 0, int(s.Start), loc)
+	}
+	end = time.Date(y, m, d, 0, 0, 0, int(s.Start+length), loc)
+	return start, end, t.Before(end)
+}
+
+// Fill specifies how intervals without any data are handled when resampling.
+type Fill int
+
+const (
+	// FillNone omits intervals without any data.
+	FillNone Fill = iota
+	// FillPrevious fills intervals without any data with a bar whose prices are all the previous close, and whose
+	// volume is 0.
+	FillPrevious
+	// FillNaN fills intervals without any data with a bar whose prices are NaN, and whose volume is 0.
+	FillNaN
+)
+
+// ResampleOptions controls how bars are built by ResampleTrades and Resample.
+type ResampleOptions struct {
+	// Session defines the trading session. Bars never span a session boundary, and data outside of a session is
+	// discarded. Bars are aligned to the start of the session.
+	Session Session
+	// Fill controls how intervals without any data are handled. Only intervals between two bars of the same session
+	// are filled.
+	Fill Fill
+}
+
+// resampler aggregates data into bars of a fixed interval.
+type resampler struct {
+	interval time.Duration
+	opts     ResampleOptions
+	volume   bool
+	out      OHLCV
+
+	sessionStart time.Time
+	last         time.Time
+}
+
+func newResampler(interval time.Duration, opts ResampleOptions, volume bool) (*resampler, error) {
+	if interval <= 0 {
+		return nil, fmt.Errorf("talib: invalid resample interval %s", interval)
+	}
+	return &resampler{interval: interval, opts: opts, volume: volume}, nil
+}
+
+func (r *resampler) add(t time.Time, open, high, low, close, volume float64) error {
+	if !r.last.IsZero() && t.Before(r.last) {
+		return fmt.Errorf("talib: time %s is before the previous time %s", t, r.last)
+	}
+	r.last = t
+
+	sessionStart, _, ok := r.opts.Session.Bounds(t)
+	if !ok {
+		return nil
+	}
+	bucket := sessionStart.Add(t.Sub(sessionStart) / r.interval * r.interval)
+
+	n := len(r.out.Time)
+	if n > 0 && r.out.Time[n-1].Equal(bucket) {
+		r.out.High[n-1] = math.Max(r.out.High[n-1], high)
+		r.out.Low[n-1] = math.Min(r.out.Low[n-1], low)
+		r.out.Close[n-1] = close
+		if r.volume {
+			r.out.Volume[n-1] += volume
+		}
+		return nil
+	}
+
+	if n > 0 && r.opts.Fill != FillNone && r.sessionStart.Equal(sessionStart) {
+		price := r.out.Close[n-1]
+		if r.opts.Fill == FillNaN {
+			price = math.NaN()
+		}
+		for b := r.out.Time[n-1].Add(r.interval); b.Before(bucket); b = b.Add(r.interval) {
+			r.append(b, price, price, price, price, 0)
+		}
+	}
+	r.sessionStart = sessionStart
+	r.append(bucket, open, high, low, close, volume)
+	return nil
+}
+
+func (r *resampler) append(t time.Time, open, high, low, close, volume float64) {
+	r.out.Time = append(r.out.Time, t)
+	r.out.Open = append(r.out.Open, open)
+	r.out.High = append(r.out.High, high)
+	r.out.Low = append(r.out.Low, low)
+	r.out.Close = append(r.out.Close, close)
+	if r.volume {
+		r.out.Volume = append(r.out.Volume, volume)
+	}
+}
+
+// ResampleTrades builds bars of the given interval from trades, which must be in ascending order of time.
+//
+// The Time of each bar is the start of its interval. The Volume of each bar is the sum of the trade sizes.
+func ResampleTrades(trades []Trade, interval time.Duration, opts ResampleOptions) (OHLCV, error) {
+	r, err := newResampler(interval, opts, true)
+	if err != nil {
+		return OHLCV{}, err
+	}
+	for _, t := range trades {
+		if err := r.add(t.Time, t.Price, t.Price, t.Price, t.Price, t.Size); err != nil {
+			return OHLCV{}, err
+		}
+	}
+	return r.out, nil
+}
+
+// Resample converts bars to a longer interval, such as 1 minute bars to 5 minute bars.
+//
+// bars must have Open, High, Low, Close and Time columns, with the Time of each bar being the start of its interval,
+// in ascending order. If bars has a Volume column, the Volume of each output bar is the sum of the input bars.
+func Resample(bars OHLCV, interval time.Duration, opts ResampleOptions) (OHLCV, error) {
+	if err := bars.Validate(); err != nil {
+		return OHLCV{}, err
+	}
+	n := bars.Len()
+	if len(bars.Time) != n || len(bars.Open) != n || len(bars.High) != n || len(bars.Low) != n || len(bars.Close) != n {
+		return OHLCV{}, fmt.Errorf("talib: Resample requires Time, Open, High, Low and Close columns")
+	}
+
+	volume := len(bars.Volume) == n
+	r, err := newResampler(interval, opts, volume)
+	if err != nil {
+		return OHLCV{}, err
+	}
+	for i, t := range bars.Time {
+		var v float64
+		if volume {
+			v = bars.Volume[i]
+		}
+		if err := r.add(t, bars.Open[i], bars.High[i], bars.Low[i], bars.Close[i], v); err != nil {
+			return OHLCV{}, err
+		}
+	}
+	return r.out, nil
+}
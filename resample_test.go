@@ -0,0 +1,86 @@
+package talib_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/phemmer/talib"
+)
+
+func minute(m int) time.Time {
+	return time.Date(2019, 1, 2, 9, m, 0, 0, time.UTC)
+}
+
+func TestResampleTrades(t *testing.T) {
+	trades := []talib.Trade{
+		{minute(0), 10, 1},
+		{minute(0).Add(10 * time.Second), 12, 2},
+		{minute(0).Add(20 * time.Second), 9, 1},
+		{minute(0).Add(50 * time.Second), 11, 3},
+		{minute(2).Add(5 * time.Second), 13, 1},
+	}
+	bars, err := talib.ResampleTrades(trades, time.Minute, talib.ResampleOptions{Fill: talib.FillPrevious})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := talib.OHLCV{
+		Time:   []time.Time{minute(0), minute(1), minute(2)},
+		Open:   []float64{10, 11, 13},
+		High:   []float64{12, 11, 13},
+		Low:    []float64{9, 11, 13},
+		Close:  []float64{11, 11, 13},
+		Volume: []float64{7, 0, 1},
+	}
+	if !reflect.DeepEqual(expected, bars) {
+		t.Errorf("Expected %#v got %#v.", expected, bars)
+	}
+}
+
+func TestResampleFillNaN(t *testing.T) {
+	trades := []talib.Trade{{minute(0), 10, 1}, {minute(2), 11, 1}}
+	bars, err := talib.ResampleTrades(trades, time.Minute, talib.ResampleOptions{Fill: talib.FillNaN})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bars.Len() != 3 || !math.IsNaN(bars.Close[1]) || bars.Volume[1] != 0 {
+		t.Errorf("Expected NaN filled bar got %#v.", bars)
+	}
+}
+
+func TestResampleSession(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip(err)
+	}
+	opts := talib.ResampleOptions{
+		Session: talib.Session{Start: 9*time.Hour + 30*time.Minute, End: 16 * time.Hour, Location: ny},
+	}
+	bars := talib.OHLCV{
+		Time: []time.Time{
+			time.Date(2019, 1, 2, 9, 0, 0, 0, ny),
+			time.Date(2019, 1, 2, 9, 30, 0, 0, ny),
+			time.Date(2019, 1, 2, 15, 59, 0, 0, ny),
+			time.Date(2019, 1, 3, 9, 30, 0, 0, ny),
+		},
+		Open:  []float64{1, 2, 3, 4},
+		High:  []float64{1, 2.5, 3.5, 4.5},
+		Low:   []float64{1, 1.5, 2.5, 3.5},
+		Close: []float64{1, 2.2, 3.2, 4.2},
+	}
+	out, err := talib.Resample(bars, 24*time.Hour, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := talib.OHLCV{
+		Time:  []time.Time{time.Date(2019, 1, 2, 9, 30, 0, 0, ny), time.Date(2019, 1, 3, 9, 30, 0, 0, ny)},
+		Open:  []float64{2, 4},
+		High:  []float64{3.5, 4.5},
+		Low:   []float64{1.5, 3.5},
+		Close: []float64{3.2, 4.2},
+	}
+	if !reflect.DeepEqual(expected, out) {
+		t.Errorf("Expected %#v got %#v.", expected, out)
+	}
+}
@@ -0,0 +1,131 @@
+package talib
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// Series is a sequence of values indexed by time.
+//
+// Time and Values must be the same length, and Time must be in ascending order.
+//
+// Series has a method for each function in the package which takes a single input and produces non-integer outputs.
+// The methods return the outputs as a Series, with each value attached to the time of the input element it corresponds
+// to.
+type Series struct {
+	Time   []time.Time
+	Values []float64
+}
+
+// Len returns the number of values in the series.
+func (s Series) Len() int {
+	return len(s.Values)
+}
+
+// Validate checks that Time and Values are the same length, and that Time is in ascending order.
+func (s Series) Validate() error {
+	if len(s.Time) != len(s.Values) {
+		return fmt.Errorf("talib: Series has %d times and %d values", len(s.Time), len(s.Values))
+	}
+	for i := 1; i < len(s.Time); i++ {
+		if !s.Time[i].After(s.Time[i-1]) {
+			return fmt.Errorf("talib: Series time at index %d is not after the previous time", i)
+		}
+	}
+	return nil
+}
+
+// search returns the index of the first element whose time is not before t.
+func (s Series) search(t time.Time) int {
+	return sort.Search(len(s.Time), func(i int) bool { return !s.Time[i].Before(t) })
+}
+
+// At returns the value at time t. The bool is false if the series has no value at t.
+func (s Series) At(t time.Time) (float64, bool) {
+	i := s.search(t)
+	if i == len(s.Time) || !s.Time[i].Equal(t) {
+		return 0, false
+	}
+	return s.Values[i], true
+}
+
+// Range returns the part of the series from time from (inclusive) to time to (exclusive).
+//
+// The returned series shares storage with s.
+func (s Series) Range(from, to time.Time) Series {
+	i, j := s.search(from), s.search(to)
+	if j < i {
+		j = i
+	}
+	return Series{Time: s.Time[i:j], Values: s.Values[i:j]}
+}
+
+// Indexed attaches the output of a function called with s.Values to the times of s.
+//
+// It can be called directly with the results of a function which has a single output. E.g.
+//
+//	rsi := s.Indexed(talib.Rsi(s.Values, 14, nil))
+func (s Series) Indexed(out []float64, begIdx int) Series {
+	if len(s.Time) == 0 && len(s.Values) > 0 {
+		panic(fmt.Errorf("talib: Series Time is missing"))
+	}
+	return indexed(s.Time, out, begIdx)
+}
+
+// Indexed attaches the output of a function called with columns of s to the times of s. It panics if s has no Time
+// column.
+//
+// It can be called directly with the results of a method which has a single output. E.g.
+//
+//	atr := s.Indexed(s.Atr(14))
+func (s OHLCV) Indexed(out []float64, begIdx int) Series {
+	s.mustValidate("Time")
+	return indexed(s.Time, out, begIdx)
+}
+
+// indexed attaches out to the times starting at begIdx, panicking if they do not fit.
+func indexed(times []time.Time, out []float64, begIdx int) Series {
+	if begIdx < 0 || begIdx+len(out) > len(times) {
+		panic(fmt.Errorf("talib: %d values starting at index %d do not fit within %d times", len(out), begIdx, len(times)))
+	}
+	return Series{Time: times[begIdx : begIdx+len(out)], Values: out}
+}
+
+// Join returns the given series restricted to the times which are present in all of them.
+//
+// The returned series all have the same length, and share the same Time slice.
+func Join(series ...Series) []Series {
+	if len(series) == 0 {
+		return nil
+	}
+
+	var times []time.Time
+	idx := make([]int, len(series))
+	out := make([]Series, len(series))
+outer:
+	for k, t := range series[0].Time {
+		idx[0] = k
+		for i, s := range series[1:] {
+			j := idx[i+1]
+			for j < len(s.Time) && s.Time[j].Before(t) {
+				j++
+			}
+			idx[i+1] = j
+			if j == len(s.Time) {
+				break outer
+			}
+			if !s.Time[j].Equal(t) {
+				continue outer
+			}
+		}
+		times = append(times, t)
+		for i, s := range series {
+			out[i].Values = append(out[i].Values, s.Values[idx[i]])
+		}
+	}
+	for i := range out {
+		out[i].Time = times
+	}
+	return out
+}
@@ -0,0 +1,77 @@
+package talib_test
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/phemmer/talib"
+)
+
+func seriesTimes(days ...int) []time.Time {
+	var times []time.Time
+	for _, d := range days {
+		times = append(times, time.Date(2019, 1, d, 0, 0, 0, 0, time.UTC))
+	}
+	return times
+}
+
+func TestSeriesAt(t *testing.T) {
+	s := talib.Series{Time: seriesTimes(1, 2, 4), Values: []float64{1, 2, 4}}
+	if v, ok := s.At(seriesTimes(4)[0]); !ok || v != 4 {
+		t.Errorf("Expected 4, true got %v, %v.", v, ok)
+	}
+	if _, ok := s.At(seriesTimes(3)[0]); ok {
+		t.Errorf("Expected no value for a gap.")
+	}
+}
+
+func TestSeriesRange(t *testing.T) {
+	s := talib.Series{Time: seriesTimes(1, 2, 4, 5), Values: []float64{1, 2, 4, 5}}
+	r := s.Range(seriesTimes(2)[0], seriesTimes(5)[0])
+	expected := talib.Series{Time: seriesTimes(2, 4), Values: []float64{2, 4}}
+	if !reflect.DeepEqual(expected, r) {
+		t.Errorf("Expected %#v got %#v.", expected, r)
+	}
+}
+
+func TestSeriesIndexed(t *testing.T) {
+	s := talib.Series{Time: seriesTimes(1, 2, 3, 4), Values: []float64{1, 2, 3, 4}}
+	r := s.Indexed([]float64{2.5, 3.5}, 2)
+	expected := talib.Series{Time: seriesTimes(3, 4), Values: []float64{2.5, 3.5}}
+	if !reflect.DeepEqual(expected, r) {
+		t.Errorf("Expected %#v got %#v.", expected, r)
+	}
+}
+
+func TestOHLCVIndexedMissingTime(t *testing.T) {
+	tests := []talib.OHLCV{
+		{Close: []float64{1, 2, 3}},
+		{Time: seriesTimes(1, 2), Close: []float64{1, 2, 3}},
+	}
+	for _, s := range tests {
+		func() {
+			defer func() {
+				if err := recover(); err == nil || !strings.Contains(fmt.Sprint(err), "Time") {
+					t.Errorf("Expected panic naming the Time column, got %v.", err)
+				}
+			}()
+			s.Indexed([]float64{2}, 2)
+		}()
+	}
+}
+
+func TestJoin(t *testing.T) {
+	a := talib.Series{Time: seriesTimes(1, 2, 3, 5), Values: []float64{1, 2, 3, 5}}
+	b := talib.Series{Time: seriesTimes(2, 4, 5, 6), Values: []float64{20, 40, 50, 60}}
+	out := talib.Join(a, b)
+	expected := []talib.Series{
+		{Time: seriesTimes(2, 5), Values: []float64{2, 5}},
+		{Time: seriesTimes(2, 5), Values: []float64{20, 50}},
+	}
+	if !reflect.DeepEqual(expected, out) {
+		t.Errorf("Expected %#v got %#v.", expected, out)
+	}
+}
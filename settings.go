@@ -0,0 +1,221 @@
+package talib
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// UnstableFunc identifies a function with an unstable period. See Settings.Unstable.
+type UnstableFunc int
+
+const (
+	UnstableAdx UnstableFunc = iota
+	UnstableAdxr
+	UnstableAtr
+	UnstableCmo
+	UnstableDx
+	UnstableEma
+	UnstableHtDcPeriod
+	UnstableHtDcPhase
+	UnstableHtPhasor
+	UnstableHtSine
+	UnstableHtTrendline
+	UnstableHtTrendMode
+	UnstableKama
+	UnstableMama
+	UnstableMfi
+	UnstableMinusDi
+	UnstableMinusDm
+	UnstableNatr
+	UnstablePlusDi
+	UnstablePlusDm
+	UnstableRsi
+	UnstableStochRsi
+	UnstableT3
+	unstableCount
+)
+
+// Compatibility selects how TA-Lib seeds some of its functions.
+type Compatibility int
+
+const (
+	// CompatibilityDefault is the TA-Lib behavior.
+	CompatibilityDefault Compatibility = iota
+	// CompatibilityMetastock matches the values produced by Metastock. E.g. Ema is seeded with the first input rather
+	// than a simple average.
+	CompatibilityMetastock
+)
+
+// CandleSettingType identifies a setting used by the candlestick pattern functions (Cdl*) to classify candles.
+type CandleSettingType int
+
+const (
+	CandleBodyLong CandleSettingType = iota
+	CandleBodyVeryLong
+	CandleBodyShort
+	CandleBodyDoji
+	CandleShadowLong
+	CandleShadowVeryLong
+	CandleShadowShort
+	CandleShadowVeryShort
+	CandleNear
+	CandleFar
+	CandleEqual
+	candleSettingCount
+)
+
+// CandleRange is the part of a candle a CandleSetting is measured against.
+type CandleRange int
+
+const (
+	CandleRangeRealBody CandleRange = iota
+	CandleRangeHighLow
+	CandleRangeShadows
+)
+
+// CandleSetting defines a candle classification as Factor times the average of Range over the last AvgPeriod candles.
+type CandleSetting struct {
+	Range     CandleRange
+	AvgPeriod int
+	Factor    float64
+}
+
+var candleDefaults = [candleSettingCount]CandleSetting{
+	CandleBodyLong:        {CandleRangeRealBody, 10, 1.0},
+	CandleBodyVeryLong:    {CandleRangeRealBody, 10, 3.0},
+	CandleBodyShort:       {CandleRangeRealBody, 10, 1.0},
+	CandleBodyDoji:        {CandleRangeHighLow, 10, 0.1},
+	CandleShadowLong:      {CandleRangeRealBody, 0, 1.0},
+	CandleShadowVeryLong:  {CandleRangeRealBody, 0, 2.0},
+	CandleShadowShort:     {CandleRangeShadows, 10, 1.0},
+	CandleShadowVeryShort: {CandleRangeHighLow, 10, 0.1},
+	CandleNear:            {CandleRangeHighLow, 5, 0.2},
+	CandleFar:             {CandleRangeHighLow, 5, 0.6},
+	CandleEqual:           {CandleRangeHighLow, 5, 0.05},
+}
+
+// Default returns the TA-Lib default for the setting.
+func (t CandleSettingType) Default() CandleSetting {
+	if t < 0 || t >= candleSettingCount {
+		return CandleSetting{}
+	}
+	return candleDefaults[t]
+}
+
+// Settings holds the TA-Lib settings which are global to the process. The zero value is the TA-Lib defaults.
+type Settings struct {
+	// Unstable holds the unstable period of functions whose outputs depend on all of the preceding input, such as Ema.
+	// The given number of additional leading outputs are discarded, so that the remaining outputs are closer to those
+	// computed from a longer input. Functions not present use 0.
+	Unstable      map[UnstableFunc]int
+	Compatibility Compatibility
+	// Candles holds the candle settings which differ from their defaults.
+	Candles map[CandleSettingType]CandleSetting
+}
+
+func (s Settings) validate() error {
+	for f, p := range s.Unstable {
+		if f < 0 || f >= unstableCount {
+			return fmt.Errorf("talib: unknown unstable function %d", int(f))
+		}
+		if p < 0 {
+			return fmt.Errorf("talib: unstable period must not be negative, got %d", p)
+		}
+	}
+	if s.Compatibility != CompatibilityDefault && s.Compatibility != CompatibilityMetastock {
+		return fmt.Errorf("talib: unknown compatibility %d", int(s.Compatibility))
+	}
+	for t, c := range s.Candles {
+		if t < 0 || t >= candleSettingCount {
+			return fmt.Errorf("talib: unknown candle setting %d", int(t))
+		}
+		if c.Range < CandleRangeRealBody || c.Range > CandleRangeShadows {
+			return fmt.Errorf("talib: unknown candle range %d", int(c.Range))
+		}
+		if c.AvgPeriod < 0 {
+			return fmt.Errorf("talib: candle average period must not be negative, got %d", c.AvgPeriod)
+		}
+	}
+	return nil
+}
+
+// key returns a string which is the same for all Settings which configure TA-Lib the same way.
+func (s Settings) key() string {
+	var parts []string
+	for f, p := range s.Unstable {
+		if p != 0 {
+			parts = append(parts, fmt.Sprintf("u%d=%d", f, p))
+		}
+	}
+	for t, c := range s.Candles {
+		if c != candleDefaults[t] {
+			parts = append(parts, fmt.Sprintf("c%d=%v", t, c))
+		}
+	}
+	sort.Strings(parts)
+	return fmt.Sprintf("%d;%s", s.Compatibility, strings.Join(parts, ";"))
+}
+
+// settings tracks the settings applied to TA-Lib, and the number of calls to WithSettings using them. Calls are admitted
+// in the order of their tickets, so that a call needing different settings is not starved by a stream of calls using
+// the current ones.
+var settings = struct {
+	sync.Mutex
+	cond    *sync.Cond
+	key     string
+	active  int
+	next    uint64
+	serving uint64
+}{key: Settings{}.key()}
+
+func init() {
+	settings.cond = sync.NewCond(&settings.Mutex)
+}
+
+// WithSettings applies the settings to TA-Lib, and calls fn.
+//
+// TA-Lib stores its settings globally, so WithSettings makes sure they are not changed while fn runs: calls using the
+// same settings run concurrently, while calls using different settings wait for each other. Calls are admitted in the
+// order they are made, so a call waiting for different settings holds up later calls. Functions called outside
+// of WithSettings use whichever settings were applied last, so to be sure of the defaults, use WithSettings with the
+// zero Settings.
+//
+// fn must not call WithSettings. A nested call using different settings waits for the outer call to return, and so
+// deadlocks; a nested call using the same settings deadlocks if another call is waiting.
+func WithSettings(s Settings, fn func()) error {
+	if err := s.validate(); err != nil {
+		return err
+	}
+	key := s.key()
+
+	settings.Lock()
+	ticket := settings.next
+	settings.next++
+	for ticket != settings.serving || (settings.active > 0 && settings.key != key) {
+		settings.cond.Wait()
+	}
+	if settings.key != key {
+		s.apply()
+		settings.key = key
+	}
+	settings.active++
+	settings.serving++
+	if settings.serving != settings.next {
+		// Let the next call in if it uses the same settings.
+		settings.cond.Broadcast()
+	}
+	settings.Unlock()
+
+	defer func() {
+		settings.Lock()
+		settings.active--
+		if settings.active == 0 {
+			settings.cond.Broadcast()
+		}
+		settings.Unlock()
+	}()
+	fn()
+	return nil
+}
@@ -0,0 +1,68 @@
+package talib
+
+// #include "ta-lib/ta_libc.h"
+import "C"
+
+var unstableIDs = [unstableCount]C.TA_FuncUnstId{
+	UnstableAdx:         C.TA_FUNC_UNST_ADX,
+	UnstableAdxr:        C.TA_FUNC_UNST_ADXR,
+	UnstableAtr:         C.TA_FUNC_UNST_ATR,
+	UnstableCmo:         C.TA_FUNC_UNST_CMO,
+	UnstableDx:          C.TA_FUNC_UNST_DX,
+	UnstableEma:         C.TA_FUNC_UNST_EMA,
+	UnstableHtDcPeriod:  C.TA_FUNC_UNST_HT_DCPERIOD,
+	UnstableHtDcPhase:   C.TA_FUNC_UNST_HT_DCPHASE,
+	UnstableHtPhasor:    C.TA_FUNC_UNST_HT_PHASOR,
+	UnstableHtSine:      C.TA_FUNC_UNST_HT_SINE,
+	UnstableHtTrendline: C.TA_FUNC_UNST_HT_TRENDLINE,
+	UnstableHtTrendMode: C.TA_FUNC_UNST_HT_TRENDMODE,
+	UnstableKama:        C.TA_FUNC_UNST_KAMA,
+	UnstableMama:        C.TA_FUNC_UNST_MAMA,
+	UnstableMfi:         C.TA_FUNC_UNST_MFI,
+	UnstableMinusDi:     C.TA_FUNC_UNST_MINUS_DI,
+	UnstableMinusDm:     C.TA_FUNC_UNST_MINUS_DM,
+	UnstableNatr:        C.TA_FUNC_UNST_NATR,
+	UnstablePlusDi:      C.TA_FUNC_UNST_PLUS_DI,
+	UnstablePlusDm:      C.TA_FUNC_UNST_PLUS_DM,
+	UnstableRsi:         C.TA_FUNC_UNST_RSI,
+	UnstableStochRsi:    C.TA_FUNC_UNST_STOCHRSI,
+	UnstableT3:          C.TA_FUNC_UNST_T3,
+}
+
+var candleIDs = [candleSettingCount]C.TA_CandleSettingType{
+	CandleBodyLong:        C.TA_BodyLong,
+	CandleBodyVeryLong:    C.TA_BodyVeryLong,
+	CandleBodyShort:       C.TA_BodyShort,
+	CandleBodyDoji:        C.TA_BodyDoji,
+	CandleShadowLong:      C.TA_ShadowLong,
+	CandleShadowVeryLong:  C.TA_ShadowVeryLong,
+	CandleShadowShort:     C.TA_ShadowShort,
+	CandleShadowVeryShort: C.TA_ShadowVeryShort,
+	CandleNear:            C.TA_Near,
+	CandleFar:             C.TA_Far,
+	CandleEqual:           C.TA_Equal,
+}
+
+var candleRanges = [...]C.TA_RangeType{
+	CandleRangeRealBody: C.TA_RangeType_RealBody,
+	CandleRangeHighLow:  C.TA_RangeType_HighLow,
+	CandleRangeShadows:  C.TA_RangeType_Shadows,
+}
+
+// apply replaces the TA-Lib settings with s, which must be valid.
+func (s Settings) apply() {
+	C.TA_SetUnstablePeriod(C.TA_FUNC_UNST_ALL, 0)
+	for f, p := range s.Unstable {
+		C.TA_SetUnstablePeriod(unstableIDs[f], C.uint(p))
+	}
+
+	C.TA_SetCompatibility(C.TA_COMPATIBILITY_DEFAULT)
+	if s.Compatibility == CompatibilityMetastock {
+		C.TA_SetCompatibility(C.TA_COMPATIBILITY_METASTOCK)
+	}
+
+	C.TA_RestoreCandleDefaultSettings(C.TA_AllCandleSettings)
+	for t, c := range s.Candles {
+		C.TA_SetCandleSettings(candleIDs[t], candleRanges[c.Range], C.int(c.AvgPeriod), C.double(c.Factor))
+	}
+}
@@ -0,0 +1,105 @@
+package talib_test
+
+import (
+	"reflect"
+	"runtime"
+	"sync"
+	"testing"
+
+	"github.com/phemmer/talib"
+)
+
+// TestWithSettingsConcurrent checks that concurrent calls using different settings do not interfere with each other.
+// Run with -race.
+func TestWithSettingsConcurrent(t *testing.T) {
+	open := []float64{10, 11, 12, 11, 10, 11, 12, 13, 12, 11, 12, 13, 14, 13, 12, 13, 14, 15, 14, 13}
+	close := []float64{11, 12, 11, 10, 11, 12, 13, 12, 11, 12, 13, 14, 13, 12, 13, 14, 15, 14, 13, 13.3}
+	high := []float64{12, 13, 13, 12, 12, 13, 14, 14, 13, 13, 14, 15, 15, 14, 14, 15, 16, 16, 15, 14}
+	low := []float64{9, 10, 10, 9, 9, 10, 11, 11, 10, 10, 11, 12, 12, 11, 11, 12, 13, 13, 12, 12}
+
+	configs := []talib.Settings{
+		{},
+		{Unstable: map[talib.UnstableFunc]int{talib.UnstableEma: 5}},
+		{Compatibility: talib.CompatibilityMetastock},
+		{Candles: map[talib.CandleSettingType]talib.CandleSetting{
+			talib.CandleBodyDoji: {Range: talib.CandleRangeHighLow, AvgPeriod: 10, Factor: 0.5},
+		}},
+	}
+	type result struct {
+		ema  []float64
+		doji []int
+	}
+	compute := func(s talib.Settings) (r result) {
+		err := talib.WithSettings(s, func() {
+			r.ema, _ = talib.Ema(close, 3, nil)
+			r.doji, _ = talib.CdlDoji(open, high, low, close, nil)
+		})
+		if err != nil {
+			t.Error(err)
+		}
+		return r
+	}
+
+	expected := make([]result, len(configs))
+	for i, s := range configs {
+		expected[i] = compute(s)
+	}
+	for i := 1; i < len(configs); i++ {
+		if reflect.DeepEqual(expected[0], expected[i]) {
+			t.Fatalf("Expected settings %d to change the results.", i)
+		}
+	}
+
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				i := (g + j) % len(configs)
+				if r := compute(configs[i]); !reflect.DeepEqual(expected[i], r) {
+					t.Errorf("Expected %#v got %#v.", expected[i], r)
+					return
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+}
+
+// TestWithSettingsFair checks that a call using different settings is not starved by overlapping calls using the
+// current settings.
+func TestWithSettingsFair(t *testing.T) {
+	wait := func(n int) {
+		for talib.SettingsWaiting() < n {
+			runtime.Gosched()
+		}
+	}
+
+	entered, release := make(chan struct{}), make(chan struct{})
+	go talib.WithSettings(talib.Settings{}, func() {
+		close(entered)
+		<-release
+	})
+	<-entered
+
+	// Queue a call using different settings, and then a call using the current settings, while the first call runs.
+	order := make(chan string, 2)
+	go talib.WithSettings(talib.Settings{Compatibility: talib.CompatibilityMetastock}, func() { order <- "different" })
+	wait(1)
+	go talib.WithSettings(talib.Settings{}, func() { order <- "same" })
+	wait(2)
+
+	close(release)
+	if first, second := <-order, <-order; first != "different" || second != "same" {
+		t.Errorf("Expected the call using different settings to run first, got %s then %s.", first, second)
+	}
+}
+
+func TestWithSettingsInvalid(t *testing.T) {
+	s := talib.Settings{Unstable: map[talib.UnstableFunc]int{talib.UnstableRsi: -1}}
+	called := false
+	if err := talib.WithSettings(s, func() { called = true }); err == nil || called {
+		t.Errorf("Expected an error without calling the function.")
+	}
+}
@@ -0,0 +1,85 @@
+package talib
+
+import "math"
+
+// crosses returns the positions in [start, end) at which diff(i) becomes positive (up) or negative (down), having
+// previously been the opposite sign. Positions where diff is 0 do not end the previous sign, so touching without
+// crossing is not a cross. NaN values reset the state.
+func crosses(start, end int, diff func(i int) float64) (up, down []int) {
+	var sign float64
+	for i := start; i < end; i++ {
+		d := diff(i)
+		switch {
+		case math.IsNaN(d):
+			sign = 0
+		case d > 0:
+			if sign < 0 {
+				up = append(up, i)
+			}
+			sign = 1
+		case d < 0:
+			if sign > 0 {
+				down = append(down, i)
+			}
+			sign = -1
+		}
+	}
+	return up, down
+}
+
+// overlap returns the range of input positions covered by both outputs.
+func overlap(a []float64, aBegIdx int, b []float64, bBegIdx int) (start, end int) {
+	start, end = aBegIdx, aBegIdx+len(a)
+	if bBegIdx > start {
+		start = bBegIdx
+	}
+	if e := bBegIdx + len(b); e < end {
+		end = e
+	}
+	return start, end
+}
+
+func crossAB(a []float64, aBegIdx int, b []float64, bBegIdx int) (up, down []int) {
+	start, end := overlap(a, aBegIdx, b, bBegIdx)
+	return crosses(start, end, func(i int) float64 { return a[i-aBegIdx] - b[i-bBegIdx] })
+}
+
+// CrossOver returns the positions in the input at which a crosses above b.
+//
+// a and b are function outputs, and aBegIdx and bBegIdx the int returned with them, so that outputs with different
+// lookbacks are compared at the same input positions. E.g.
+//
+//	fast, fastIdx := talib.Sma(close, 10, nil)
+//	slow, slowIdx := talib.Sma(close, 30, nil)
+//	buys := talib.CrossOver(fast, fastIdx, slow, slowIdx)
+//
+// A cross occurs at the position where a becomes greater than b, having previously been less than b. Positions where
+// they are equal are skipped over.
+func CrossOver(a []float64, aBegIdx int, b []float64, bBegIdx int) []int {
+	up, _ := crossAB(a, aBegIdx, b, bBegIdx)
+	return up
+}
+
+// CrossUnder returns the positions in the input at which a crosses below b. See CrossOver.
+func CrossUnder(a []float64, aBegIdx int, b []float64, bBegIdx int) []int {
+	_, down := crossAB(a, aBegIdx, b, bBegIdx)
+	return down
+}
+
+// CrossThreshold returns the positions in the input at which real crosses above and below level. begIdx is the int
+// returned with real. See CrossOver.
+func CrossThreshold(real []float64, begIdx int, level float64) (above, below []int) {
+	return crosses(begIdx, begIdx+len(real), func(i int) float64 { return real[i-begIdx] - level })
+}
+
+// Between returns the positions in the input at which real is within lower and upper, inclusive. begIdx is the int
+// returned with real.
+func Between(real []float64, begIdx int, lower, upper float64) []int {
+	var out []int
+	for i, v := range real {
+		if v >= lower && v <= upper {
+			out = append(out, begIdx+i)
+		}
+	}
+	return out
+}
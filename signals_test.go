@@ -0,0 +1,35 @@
+package talib_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/phemmer/talib"
+)
+
+func TestCrossOver(t *testing.T) {
+	// fast covers input positions 1-7, slow covers 3-7.
+	fast := []float64{0, 1, 2, 5, 5, 3, 6}
+	slow := []float64{3, 4, 5, 4, 4}
+	if out := talib.CrossOver(fast, 1, slow, 3); !reflect.DeepEqual([]int{4, 7}, out) {
+		t.Errorf("Expected %#v got %#v.", []int{4, 7}, out)
+	}
+	if out := talib.CrossUnder(fast, 1, slow, 3); !reflect.DeepEqual([]int{6}, out) {
+		t.Errorf("Expected %#v got %#v.", []int{6}, out)
+	}
+}
+
+func TestCrossThreshold(t *testing.T) {
+	rsi := []float64{40, 30, 25, 30, 29, 31, 35}
+	above, below := talib.CrossThreshold(rsi, 14, 30)
+	if !reflect.DeepEqual([]int{19}, above) || !reflect.DeepEqual([]int{16}, below) {
+		t.Errorf("Expected %#v, %#v got %#v, %#v.", []int{19}, []int{16}, above, below)
+	}
+}
+
+func TestBetween(t *testing.T) {
+	out := talib.Between([]float64{10, 20, 30, 40}, 2, 20, 30)
+	if !reflect.DeepEqual([]int{3, 4}, out) {
+		t.Errorf("Expected %#v got %#v.", []int{3, 4}, out)
+	}
+}
@@ -0,0 +1,147 @@
+package talib
+
+import "math"
+
+// Swing is a swing high or low.
+type Swing struct {
+	// Index is the position of the swing in the input.
+	Index int
+	// Price is the high of a swing high, or the low of a swing low.
+	Price float64
+	// High is true for a swing high, and false for a swing low.
+	High bool
+	// Confirmed is the position in the input at which the swing could first be identified, or -1 if it has not been
+	// confirmed. Using a swing before this position would use data from the future.
+	Confirmed int
+}
+
+// Swings returns the swing highs and lows of the given data, in order. A swing high is a high which is greater than the
+// left preceding highs, and not less than the right following highs. Swing lows are the inverse. For a single series,
+// such as closing prices or an indicator, pass it as both high and low.
+//
+// Each swing is confirmed right bars after it occurs.
+func Swings(high, low []float64, left, right int) []Swing {
+	var swings []Swing
+	for i := left; i+right < len(high); i++ {
+		isHigh, isLow := true, true
+		for j := i - left; j <= i+right && (isHigh || isLow); j++ {
+			if j == i {
+				continue
+			}
+			if j < i {
+				isHigh = isHigh && high[i] > high[j]
+				isLow = isLow && low[i] < low[j]
+			} else {
+				isHigh = isHigh && high[i] >= high[j]
+				isLow = isLow && low[i] <= low[j]
+			}
+		}
+		if isHigh {
+			swings = append(swings, Swing{Index: i, Price: high[i], High: true, Confirmed: i + right})
+		}
+		if isLow {
+			swings = append(swings, Swing{Index: i, Price: low[i], Confirmed: i + right})
+		}
+	}
+	return swings
+}
+
+// ZigZagOptions controls the reversal threshold of ZigZag. Either Percent, or AtrPeriod and AtrMultiplier, must be set
+// to a positive value.
+type ZigZagOptions struct {
+	// Percent is the reversal threshold as a fraction of the price of the last pivot, e.g. 0.05 for 5%.
+	Percent float64
+	// AtrPeriod and AtrMultiplier set the reversal threshold to AtrMultiplier times the average true range over
+	// AtrPeriod. If AtrPeriod is set, Percent is ignored.
+	AtrPeriod     int
+	AtrMultiplier float64
+	// ConfirmedOnly omits the last pivot if it has not been confirmed. Otherwise the last pivot is the most extreme
+	// price since the previous pivot, and may move as more data arrives.
+	ConfirmedOnly bool
+}
+
+// ZigZag returns the pivots of the ZigZag indicator, which alternate between highs and lows. A pivot is confirmed once
+// the price reverses from it by at least the threshold, and Swing.Confirmed is set to the position at which that
+// happens. close is only needed for an ATR threshold. If the threshold is not positive, nil is returned.
+func ZigZag(high, low, close []float64, opts ZigZagOptions) []Swing {
+	if (opts.AtrPeriod > 0 && !(opts.AtrMultiplier > 0)) || (opts.AtrPeriod <= 0 && !(opts.Percent > 0)) {
+		return nil
+	}
+	n := len(high)
+	if n == 0 {
+		return nil
+	}
+	var atr []float64
+	if opts.AtrPeriod > 0 {
+		atr, _ = AtrAligned(high, low, close, opts.AtrPeriod, nil)
+	}
+	threshold := func(i int, pivot float64) float64 {
+		if atr != nil {
+			return opts.AtrMultiplier * atr[i]
+		}
+		return math.Abs(pivot) * opts.Percent
+	}
+	reversed := func(i int, from, to float64) bool {
+		t := threshold(i, from)
+		return !math.IsNaN(t) && math.Abs(to-from) >= t
+	}
+
+	var pivots []Swing
+	// Until the first pivot is confirmed, track both the highest high and the lowest low.
+	hi := Swing{Index: 0, Price: high[0], High: true, Confirmed: -1}
+	lo := Swing{Index: 0, Price: low[0], Confirmed: -1}
+	var cand *Swing
+	for i := 0; i < n; i++ {
+		if cand == nil {
+			if high[i] > hi.Price {
+				hi.Index, hi.Price = i, high[i]
+			}
+			if low[i] < lo.Price {
+				lo.Index, lo.Price = i, low[i]
+			}
+			switch {
+			case hi.Index > lo.Index && reversed(i, lo.Price, hi.Price):
+				lo.Confirmed = i
+				pivots = append(pivots, lo)
+				cand = &hi
+			case lo.Index > hi.Index && reversed(i, hi.Price, lo.Price):
+				hi.Confirmed = i
+				pivots = append(pivots, hi)
+				cand = &lo
+			}
+			continue
+		}
+
+		if cand.High {
+			if high[i] > cand.Price {
+				cand.Index, cand.Price = i, high[i]
+			} else if reversed(i, cand.Price, low[i]) {
+				cand.Confirmed = i
+				pivots = append(pivots, *cand)
+				cand = &Swing{Index: i, Price: low[i], Confirmed: -1}
+			}
+		} else {
+			if low[i] < cand.Price {
+				cand.Index, cand.Price = i, low[i]
+			} else if reversed(i, cand.Price, high[i]) {
+				cand.Confirmed = i
+				pivots = append(pivots, *cand)
+				cand = &Swing{Index: i, Price: high[i], High: true, Confirmed: -1}
+			}
+		}
+	}
+	if cand != nil && !opts.ConfirmedOnly {
+		pivots = append(pivots, *cand)
+	}
+	return pivots
+}
+
+// ZigZag calls ZigZag with the High, Low and Close columns of s.
+func (s OHLCV) ZigZag(opts ZigZagOptions) []Swing {
+	columns := []string{"High", "Low"}
+	if opts.AtrPeriod > 0 {
+		columns = append(columns, "Close")
+	}
+	s.mustValidate(columns...)
+	return ZigZag(s.High, s.Low, s.Close, opts)
+}
@@ -0,0 +1,52 @@
+package talib_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/phemmer/talib"
+)
+
+func TestSwings(t *testing.T) {
+	price := []float64{1, 3, 2, 1, 2, 4, 4, 3}
+	swings := talib.Swings(price, price, 1, 1)
+	expected := []talib.Swing{
+		{Index: 1, Price: 3, High: true, Confirmed: 2},
+		{Index: 3, Price: 1, Confirmed: 4},
+		{Index: 5, Price: 4, High: true, Confirmed: 6},
+	}
+	if !reflect.DeepEqual(expected, swings) {
+		t.Errorf("Expected %#v got %#v.", expected, swings)
+	}
+}
+
+func TestZigZag(t *testing.T) {
+	price := []float64{100, 104, 110, 107, 104, 99, 101, 103, 110, 108}
+	expected := []talib.Swing{
+		{Index: 0, Price: 100, Confirmed: 2},
+		{Index: 2, Price: 110, High: true, Confirmed: 5},
+		{Index: 5, Price: 99, Confirmed: 8},
+		{Index: 8, Price: 110, High: true, Confirmed: -1},
+	}
+	pivots := talib.ZigZag(price, price, nil, talib.ZigZagOptions{Percent: 0.08})
+	if !reflect.DeepEqual(expected, pivots) {
+		t.Errorf("Expected %#v got %#v.", expected, pivots)
+	}
+
+	pivots = talib.ZigZag(price, price, nil, talib.ZigZagOptions{Percent: 0.08, ConfirmedOnly: true})
+	if !reflect.DeepEqual(expected[:3], pivots) {
+		t.Errorf("Expected %#v got %#v.", expected[:3], pivots)
+	}
+
+	for _, opts := range []talib.ZigZagOptions{{}, {AtrPeriod: 3}, {Percent: -0.08}} {
+		if pivots := talib.ZigZag(price, price, price, opts); pivots != nil {
+			t.Errorf("Expected nil for %#v, got %#v.", opts, pivots)
+		}
+	}
+
+	for _, opts := range []talib.ZigZagOptions{{Percent: 0.08}, {AtrPeriod: 3, AtrMultiplier: 2}} {
+		if pivots := talib.ZigZag(nil, nil, nil, opts); pivots != nil {
+			t.Errorf("Expected nil for empty input with %#v, got %#v.", opts, pivots)
+		}
+	}
+}
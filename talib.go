@@ -9,5 +9,15 @@ Return slice - This will be the same as outReal, but subsliced to remove unused
 
 Return int - This will be the position in the input slice that corresponds to the first element of the output slice.
 
+Aligned - Each function also has an Aligned variant (e.g. RsiAligned) whose outputs are the same length as the input, with the positions before the return int filled with NaN (or 0 for integer outputs), so that output element i corresponds to input element i.
+
+Functions which take price data (open, high, low, close & volume) are also available as methods on OHLCV, which pass the appropriate columns of the series to the function.
+
+Functions which take a single input are also available as methods on Series, which return the outputs indexed by time. The outputs of other functions can be indexed with Series.Indexed or OHLCV.Indexed.
+
+Functions can also be called by name, using Functions and LookupFunction. Batch uses this to compute a set of indicators for many symbols concurrently.
+
+TA-Lib's unstable periods, compatibility mode and candle settings are global to the process. Use WithSettings to change them safely when functions are called from multiple goroutines.
+
 */
 package talib
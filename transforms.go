@@ -0,0 +1,164 @@
+package talib
+
+import (
+	"math"
+	"time"
+)
+
+// HeikinAshi returns the Heikin-Ashi bars of the bars, which must have Open, High, Low and Close columns, and the index
+// of the source bar of each, which is the same bar. The Time and Volume columns are copied.
+//
+// The close is the average of the open, high, low and close, and the open is the average of the previous Heikin-Ashi
+// open and close. The high and low are extended to include the open and close.
+func HeikinAshi(bars OHLCV) (OHLCV, []int) {
+	bars.mustValidate("Open", "High", "Low", "Close")
+	n := len(bars.Close)
+	ha := OHLCV{
+		Time:   bars.Time,
+		Open:   make([]float64, n),
+		High:   make([]float64, n),
+		Low:    make([]float64, n),
+		Close:  make([]float64, n),
+		Volume: bars.Volume,
+	}
+	index := make([]int, n)
+	for i := 0; i < n; i++ {
+		c := (bars.Open[i] + bars.High[i] + bars.Low[i] + bars.Close[i]) / 4
+		o := (bars.Open[i] + bars.Close[i]) / 2
+		if i > 0 {
+			o = (ha.Open[i-1] + ha.Close[i-1]) / 2
+		}
+		ha.Open[i], ha.Close[i] = o, c
+		ha.High[i] = math.Max(bars.High[i], math.Max(o, c))
+		ha.Low[i] = math.Min(bars.Low[i], math.Min(o, c))
+		index[i] = i
+	}
+	return ha, index
+}
+
+// brickBuilder accumulates the bars of a Renko or Point and Figure chart.
+type brickBuilder struct {
+	bars  OHLCV
+	times []time.Time
+	index []int
+}
+
+func (b *brickBuilder) add(from, to float64, i int) {
+	b.bars.Open = append(b.bars.Open, from)
+	b.bars.High = append(b.bars.High, math.Max(from, to))
+	b.bars.Low = append(b.bars.Low, math.Min(from, to))
+	b.bars.Close = append(b.bars.Close, to)
+	if len(b.times) > 0 {
+		b.bars.Time = append(b.bars.Time, b.times[i])
+	}
+	b.index = append(b.index, i)
+}
+
+// Renko returns the Renko bricks of the closes of the bars, and the index of the source bar which completed each brick.
+//
+// A brick is added each time the close moves boxSize beyond the last brick. A reversal therefore needs the close to move
+// two boxes from the close of the last brick. Each brick is a bar whose open and close are the two ends of the brick,
+// and whose time is that of the source bar. The bricks have no volume.
+func Renko(bars OHLCV, boxSize float64) (OHLCV, []int) {
+	bars.mustValidate("Close")
+	b := brickBuilder{times: bars.Time}
+	if len(bars.Close) == 0 || !(boxSize > 0) {
+		return b.bars, b.index
+	}
+
+	top, bottom := bars.Close[0], bars.Close[0]
+	for i, c := range bars.Close {
+		for c >= top+boxSize {
+			b.add(top, top+boxSize, i)
+			bottom, top = top, top+boxSize
+		}
+		for c <= bottom-boxSize {
+			b.add(bottom, bottom-boxSize, i)
+			top, bottom = bottom, bottom-boxSize
+		}
+	}
+	return b.bars, b.index
+}
+
+// RenkoAtr is the same as Renko, but the box size is the average true range over timePeriod at bar at, of the bars,
+// which must have High, Low and Close columns. The box size used is also returned, or NaN if the ATR is not available
+// at bar at (e.g. at is less than timePeriod).
+//
+// To avoid look-ahead bias, the bricks are built from the close of bar at onwards, so that no brick is sized using
+// bars after the one which completed it. Use the first bar for which the ATR is available (at = timePeriod) to chart
+// as much of the data as possible.
+func RenkoAtr(bars OHLCV, timePeriod, at int) (OHLCV, []int, float64) {
+	bars.mustValidate("High", "Low", "Close")
+	atr, begIdx := Atr(bars.High, bars.Low, bars.Close, timePeriod, nil)
+	if at < begIdx || at-begIdx >= len(atr) {
+		return OHLCV{}, nil, math.NaN()
+	}
+	boxSize := atr[at-begIdx]
+	from := OHLCV{Close: bars.Close[at:]}
+	if len(bars.Time) > 0 {
+		from.Time = bars.Time[at:]
+	}
+	bricks, index := Renko(from, boxSize)
+	for i := range index {
+		index[i] += at
+	}
+	return bricks, index, boxSize
+}
+
+// PointFigure returns the Point and Figure columns of the bars, and the index of the last source bar which extended
+// each column.
+//
+// Prices are rounded to multiples of boxSize, and a column of Xs (rising) is extended while the high reaches new boxes.
+// A column of Os (falling) starts once the low falls reversal boxes below the top of the column of Xs, and vice versa.
+// If a bar both extends a column and reaches a reversal, the column is extended. If the bars have no High and Low
+// columns, the Close column is used.
+//
+// Each column is a bar whose open is the first box of the column and close the last, so Xs have a close above the open,
+// and Os below. The time of each column is that of its last source bar. The columns have no volume.
+func PointFigure(bars OHLCV, boxSize float64, reversal int) (OHLCV, []int) {
+	bars.mustValidate()
+	b := brickBuilder{times: bars.Time}
+	high, low := bars.High, bars.Low
+	if len(high) == 0 || len(low) == 0 {
+		high, low = bars.Close, bars.Close
+	}
+	if len(high) == 0 || !(boxSize > 0) || reversal < 1 {
+		return b.bars, b.index
+	}
+
+	// floor and ceil round to a box, allowing for floating point error.
+	floor := func(v float64) float64 { return math.Floor(v/boxSize+1e-9) * boxSize }
+	ceil := func(v float64) float64 { return math.Ceil(v/boxSize-1e-9) * boxSize }
+	rev := float64(reversal) * boxSize
+
+	ref := math.Round((high[0]+low[0])/2/boxSize) * boxSize
+	if len(bars.Close) > 0 {
+		ref = math.Round(bars.Close[0]/boxSize) * boxSize
+	}
+	dir := 0
+	var from, to float64 // the first and last box of the current column
+	var last int         // the last bar extending the current column
+	for i := range high {
+		h, l := floor(high[i]), ceil(low[i])
+		switch {
+		case dir == 0 && h >= ref+boxSize:
+			dir, from, to, last = 1, ref, h, i
+		case dir == 0 && l <= ref-boxSize:
+			dir, from, to, last = -1, ref, l, i
+		case dir == 1 && h > to:
+			to, last = h, i
+		case dir == 1 && l <= to-rev:
+			b.add(from, to, last)
+			dir, from, to, last = -1, to-boxSize, l, i
+		case dir == -1 && l < to:
+			to, last = l, i
+		case dir == -1 && h >= to+rev:
+			b.add(from, to, last)
+			dir, from, to, last = 1, to+boxSize, h, i
+		}
+	}
+	if dir != 0 {
+		b.add(from, to, last)
+	}
+	return b.bars, b.index
+}
@@ -0,0 +1,88 @@
+package talib_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/phemmer/talib"
+)
+
+func TestHeikinAshi(t *testing.T) {
+	bars := talib.OHLCV{
+		Open:  []float64{10, 12},
+		High:  []float64{13, 14},
+		Low:   []float64{9, 11},
+		Close: []float64{12, 13},
+	}
+	ha, index := talib.HeikinAshi(bars)
+	expected := talib.OHLCV{
+		Open:  []float64{11, 11},
+		High:  []float64{13, 14},
+		Low:   []float64{9, 11},
+		Close: []float64{11, 12.5},
+	}
+	if !reflect.DeepEqual(expected, ha) || !reflect.DeepEqual([]int{0, 1}, index) {
+		t.Errorf("Expected %#v got %#v %#v.", expected, ha, index)
+	}
+}
+
+func TestRenko(t *testing.T) {
+	bars := talib.OHLCV{Close: []float64{10, 11, 13.5, 12, 11, 9.9, 12.1}}
+	bricks, index := talib.Renko(bars, 1)
+	expected := talib.OHLCV{
+		Open:  []float64{10, 11, 12, 12, 11, 11},
+		High:  []float64{11, 12, 13, 12, 11, 12},
+		Low:   []float64{10, 11, 12, 11, 10, 11},
+		Close: []float64{11, 12, 13, 11, 10, 12},
+	}
+	if !reflect.DeepEqual(expected, bricks) {
+		t.Errorf("Expected %#v got %#v.", expected, bricks)
+	}
+	if expected := []int{1, 2, 2, 4, 5, 6}; !reflect.DeepEqual(expected, index) {
+		t.Errorf("Expected %#v got %#v.", expected, index)
+	}
+}
+
+func TestRenkoAtr(t *testing.T) {
+	// The true range of each of the first bars is 1.
+	bars := talib.OHLCV{
+		High:  []float64{10.5, 11, 11.5, 12, 12.5, 13.5, 14.5, 15.5},
+		Low:   []float64{9.5, 10, 10.5, 11, 11.5, 12.5, 13.5, 14.5},
+		Close: []float64{10, 10.5, 11, 11.5, 12, 13, 14, 15},
+	}
+	bricks, index, boxSize := talib.RenkoAtr(bars, 2, 2)
+	expected := talib.OHLCV{
+		Open:  []float64{11, 12, 13, 14},
+		High:  []float64{12, 13, 14, 15},
+		Low:   []float64{11, 12, 13, 14},
+		Close: []float64{12, 13, 14, 15},
+	}
+	if boxSize != 1 || !reflect.DeepEqual(expected, bricks) || !reflect.DeepEqual([]int{4, 5, 6, 7}, index) {
+		t.Errorf("Expected %#v %#v 1 got %#v %#v %v.", expected, []int{4, 5, 6, 7}, bricks, index, boxSize)
+	}
+
+	if _, index, _ := talib.RenkoAtr(bars, 2, 1); index != nil {
+		t.Errorf("Expected no bricks before the ATR is available, got %#v.", index)
+	}
+}
+
+func TestPointFigure(t *testing.T) {
+	bars := talib.OHLCV{
+		High:  []float64{10.5, 12.5, 13.2, 12, 11, 10, 12.5},
+		Low:   []float64{9.5, 10.5, 12, 10.8, 9.7, 9, 10},
+		Close: []float64{10, 12, 13, 11, 10, 9.5, 12},
+	}
+	cols, index := talib.PointFigure(bars, 1, 3)
+	expected := talib.OHLCV{
+		Open:  []float64{10, 12, 10},
+		High:  []float64{13, 12, 12},
+		Low:   []float64{10, 9, 10},
+		Close: []float64{13, 9, 12},
+	}
+	if !reflect.DeepEqual(expected, cols) {
+		t.Errorf("Expected %#v got %#v.", expected, cols)
+	}
+	if expected := []int{2, 5, 6}; !reflect.DeepEqual(expected, index) {
+		t.Errorf("Expected %#v got %#v.", expected, index)
+	}
+}
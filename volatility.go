@@ -0,0 +1,360 @@
+package talib
+
+import "math"
+
+// Vortex returns the positive and negative lines of the Vortex Indicator: the sums over timePeriod of the distance from
+// the previous low to the high (positive) and from the previous high to the low (negative), each divided by the sum of
+// the true range.
+func Vortex(high, low, close []float64, timePeriod int, outVortexPlus []float64, outVortexMinus []float64) ([]float64, []float64, int) {
+	if outVortexPlus == nil {
+		outVortexPlus = make([]float64, len(close))
+	}
+	if outVortexMinus == nil {
+		outVortexMinus = make([]float64, len(close))
+	}
+	begIdx := timePeriod
+	if timePeriod < 1 || begIdx >= len(close) {
+		return outVortexPlus[:0], outVortexMinus[:0], 0
+	}
+
+	// tr[0], vmPlus[0] and vmMinus[0] correspond to close[1]. They are calculated before any output is written, so that
+	// the outputs may be the same slices as the inputs.
+	tr, _ := Trange(high, low, close, nil)
+	vmPlus, vmMinus := make([]float64, len(tr)), make([]float64, len(tr))
+	for i := range tr {
+		vmPlus[i] = math.Abs(high[i+1] - low[i])
+		vmMinus[i] = math.Abs(low[i+1] - high[i])
+	}
+	var sumPlus, sumMinus, sumTr float64
+	for i := 1; i < len(close); i++ {
+		sumPlus += vmPlus[i-1]
+		sumMinus += vmMinus[i-1]
+		sumTr += tr[i-1]
+		if j := i - timePeriod; j >= 1 {
+			sumPlus -= vmPlus[j-1]
+			sumMinus -= vmMinus[j-1]
+			sumTr -= tr[j-1]
+		}
+		if i >= begIdx {
+			outVortexPlus[i-begIdx], outVortexMinus[i-begIdx] = math.NaN(), math.NaN()
+			if sumTr > 0 {
+				outVortexPlus[i-begIdx] = sumPlus / sumTr
+				outVortexMinus[i-begIdx] = sumMinus / sumTr
+			}
+		}
+	}
+	n := len(close) - begIdx
+	return outVortexPlus[:n], outVortexMinus[:n], begIdx
+}
+
+// Chop returns the Choppiness Index: 100 * log10(sum of the true range over timePeriod / (highest high - lowest low))
+// / log10(timePeriod). It is near 100 in a sideways market, and near 0 in a trending one.
+func Chop(high, low, close []float64, timePeriod int, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(close))
+	}
+	begIdx := timePeriod
+	if timePeriod < 2 || begIdx >= len(close) {
+		return outReal[:0], 0
+	}
+
+	// tr[0] corresponds to close[1], and hi[0] and lo[0] to close[timePeriod-1].
+	tr, _ := Trange(high, low, close, nil)
+	hi, _ := Max(high, timePeriod, nil)
+	lo, _ := Min(low, timePeriod, nil)
+	var sumTr float64
+	for i := 1; i < len(close); i++ {
+		sumTr += tr[i-1]
+		if i > timePeriod {
+			sumTr -= tr[i-timePeriod-1]
+		}
+		if i >= begIdx {
+			outReal[i-begIdx] = math.NaN()
+			if r := hi[i-timePeriod+1] - lo[i-timePeriod+1]; r > 0 {
+				outReal[i-begIdx] = 100 * math.Log10(sumTr/r) / math.Log10(float64(timePeriod))
+			}
+		}
+	}
+	return outReal[:len(close)-begIdx], begIdx
+}
+
+// Ulcer returns the Ulcer Index: the root mean square over timePeriod of the percentage drawdown of real from its
+// highest value over timePeriod.
+func Ulcer(real []float64, timePeriod int, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(real))
+	}
+	begIdx := 2*timePeriod - 2
+	if timePeriod < 2 || begIdx >= len(real) {
+		return outReal[:0], 0
+	}
+
+	// hi[0] corresponds to real[timePeriod-1].
+	hi, _ := Max(real, timePeriod, nil)
+	dd := make([]float64, len(hi))
+	for i := range dd {
+		d := 100 * (real[i+timePeriod-1] - hi[i]) / hi[i]
+		dd[i] = d * d
+	}
+	out := sma(dd, timePeriod, outReal)
+	for i := range out {
+		out[i] = math.Sqrt(out[i])
+	}
+	return out, begIdx
+}
+
+// ChaikinVolatility returns Chaikin's Volatility: the percentage rate of change over rocPeriod of the exponential moving
+// average over emaPeriod of the high-low range. The usual periods are 10 and 10.
+func ChaikinVolatility(high, low []float64, emaPeriod, rocPeriod int, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(high))
+	}
+	begIdx := emaPeriod - 1 + rocPeriod
+	if emaPeriod < 1 || rocPeriod < 1 || begIdx >= len(high) {
+		return outReal[:0], 0
+	}
+
+	r := make([]float64, len(high))
+	for i := range r {
+		r[i] = high[i] - low[i]
+	}
+	e := ema(r, emaPeriod, 2/float64(emaPeriod+1), r)
+	for i := rocPeriod; i < len(e); i++ {
+		outReal[i-rocPeriod] = 100 * (e[i]/e[i-rocPeriod] - 1)
+	}
+	return outReal[:len(e)-rocPeriod], begIdx
+}
+
+// MassIndex returns the Mass Index: the sum over sumPeriod of the ratio of the exponential moving average over emaPeriod
+// of the high-low range to the exponential moving average of that average. The usual periods are 9 and 25.
+func MassIndex(high, low []float64, emaPeriod, sumPeriod int, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(high))
+	}
+	begIdx := 2*emaPeriod + sumPeriod - 3
+	if emaPeriod < 1 || sumPeriod < 1 || begIdx >= len(high) {
+		return outReal[:0], 0
+	}
+
+	alpha := 2 / float64(emaPeriod+1)
+	r := make([]float64, len(high))
+	for i := range r {
+		r[i] = high[i] - low[i]
+	}
+	single := ema(r, emaPeriod, alpha, r)
+	double := ema(single, emaPeriod, alpha, make([]float64, len(single)))
+	ratio := make([]float64, len(double))
+	for i := range ratio {
+		ratio[i] = single[i+emaPeriod-1] / double[i]
+	}
+	var sum float64
+	for i, v := range ratio {
+		sum += v
+		if i >= sumPeriod {
+			sum -= ratio[i-sumPeriod]
+		}
+		if i >= sumPeriod-1 {
+			outReal[i-sumPeriod+1] = sum
+		}
+	}
+	return outReal[:len(ratio)-sumPeriod+1], begIdx
+}
+
+// VolatilityEstimator is a method of estimating historical volatility from prices.
+type VolatilityEstimator int
+
+const (
+	// VolatilityCloseToClose is the sample standard deviation of the log returns from close to close.
+	VolatilityCloseToClose VolatilityEstimator = iota
+	// VolatilityParkinson uses the high-low range of each bar.
+	VolatilityParkinson
+	// VolatilityGarmanKlass uses the high-low range and the open-close change of each bar.
+	VolatilityGarmanKlass
+	// VolatilityRogersSatchell uses the high, low, open and close of each bar, and is unaffected by drift.
+	VolatilityRogersSatchell
+	// VolatilityYangZhang combines the overnight (previous close to open) variance, the open-close variance and the
+	// Rogers-Satchell variance. It is unaffected by drift and opening gaps.
+	VolatilityYangZhang
+)
+
+// HistoricalVolatility returns the historical volatility over timePeriod using the given estimator, annualized by
+// multiplying by the square root of periodsPerYear (e.g. 252 for daily bars). Pass 1 for the volatility per bar.
+// Only the Close column is used by VolatilityCloseToClose, and open is only used by VolatilityGarmanKlass,
+// VolatilityRogersSatchell and VolatilityYangZhang.
+//
+// The estimators which use the previous close (VolatilityCloseToClose and VolatilityYangZhang) start at timePeriod,
+// and the others at timePeriod - 1.
+func HistoricalVolatility(open, high, low, close []float64, timePeriod int, estimator VolatilityEstimator, periodsPerYear float64, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(close))
+	}
+	begIdx := timePeriod - 1
+	if estimator == VolatilityCloseToClose || estimator == VolatilityYangZhang {
+		begIdx = timePeriod
+	}
+	if timePeriod < 2 || begIdx >= len(close) {
+		return outReal[:0], 0
+	}
+
+	// rs returns the Rogers-Satchell term of bar i.
+	rs := func(i int) float64 {
+		return math.Log(high[i]/close[i])*math.Log(high[i]/open[i]) + math.Log(low[i]/close[i])*math.Log(low[i]/open[i])
+	}
+	// sampleVariance returns the sample variance of f(j) for the timePeriod bars ending at i.
+	sampleVariance := func(i int, f func(int) float64) float64 {
+		var sum, sumSq float64
+		for j := i - timePeriod + 1; j <= i; j++ {
+			v := f(j)
+			sum += v
+			sumSq += v * v
+		}
+		n := float64(timePeriod)
+		return (sumSq - sum*sum/n) / (n - 1)
+	}
+	// mean returns the mean of f(j) for the timePeriod bars ending at i.
+	mean := func(i int, f func(int) float64) float64 {
+		var sum float64
+		for j := i - timePeriod + 1; j <= i; j++ {
+			sum += f(j)
+		}
+		return sum / float64(timePeriod)
+	}
+
+	var variance []float64
+	switch estimator {
+	case VolatilityCloseToClose:
+		returns := make([]float64, len(close)-1)
+		for i := range returns {
+			returns[i] = math.Log(close[i+1] / close[i])
+		}
+		// StdDev is the population standard deviation.
+		sd, _ := StdDev(returns, timePeriod, 1, nil)
+		variance = make([]float64, len(sd))
+		for i, v := range sd {
+			variance[i] = v * v * float64(timePeriod) / float64(timePeriod-1)
+		}
+	case VolatilityParkinson:
+		variance = make([]float64, len(close)-begIdx)
+		for i := range variance {
+			variance[i] = mean(i+begIdx, func(j int) float64 {
+				hl := math.Log(high[j] / low[j])
+				return hl * hl
+			}) / (4 * math.Ln2)
+		}
+	case VolatilityGarmanKlass:
+		variance = make([]float64, len(close)-begIdx)
+		for i := range variance {
+			variance[i] = mean(i+begIdx, func(j int) float64 {
+				hl, co := math.Log(high[j]/low[j]), math.Log(close[j]/open[j])
+				return 0.5*hl*hl - (2*math.Ln2-1)*co*co
+			})
+		}
+	case VolatilityRogersSatchell:
+		variance = make([]float64, len(close)-begIdx)
+		for i := range variance {
+			variance[i] = mean(i+begIdx, rs)
+		}
+	case VolatilityYangZhang:
+		n := float64(timePeriod)
+		k := 0.34 / (1.34 + (n+1)/(n-1))
+		variance = make([]float64, len(close)-begIdx)
+		for i := range variance {
+			overnight := sampleVariance(i+begIdx, func(j int) float64 { return math.Log(open[j] / close[j-1]) })
+			openClose := sampleVariance(i+begIdx, func(j int) float64 { return math.Log(close[j] / open[j]) })
+			variance[i] = overnight + k*openClose + (1-k)*mean(i+begIdx, rs)
+		}
+	default:
+		return outReal[:0], 0
+	}
+
+	for i, v := range variance {
+		outReal[i] = math.Sqrt(math.Max(v, 0) * periodsPerYear)
+	}
+	return outReal[:len(variance)], begIdx
+}
+
+// ElderRay returns Elder's Bull Power and Bear Power: the high and the low less the exponential moving average of close
+// over timePeriod. The usual period is 13.
+func ElderRay(high, low, close []float64, timePeriod int, outBullPower []float64, outBearPower []float64) ([]float64, []float64, int) {
+	if outBullPower == nil {
+		outBullPower = make([]float64, len(close))
+	}
+	if outBearPower == nil {
+		outBearPower = make([]float64, len(close))
+	}
+	e, begIdx := Ema(close, timePeriod, nil)
+	for i, v := range e {
+		outBullPower[i] = high[i+begIdx] - v
+		outBearPower[i] = low[i+begIdx] - v
+	}
+	return outBullPower[:len(e)], outBearPower[:len(e)], begIdx
+}
+
+// AroonTrend returns the trend indicated by AroOn over timePeriod: 1 where Aroon Up is at least strength and Aroon Down
+// at most 100 - strength (an up trend), -1 for the reverse (a down trend), and 0 where neither line dominates, as in a
+// consolidation. The usual strength is 70.
+func AroonTrend(high, low []float64, timePeriod int, strength float64, outInteger []int) ([]int, int) {
+	if outInteger == nil {
+		outInteger = make([]int, len(high))
+	}
+	down, up, begIdx := AroOn(high, low, timePeriod, nil, nil)
+	for i := range up {
+		outInteger[i] = 0
+		switch {
+		case up[i] >= strength && down[i] <= 100-strength:
+			outInteger[i] = 1
+		case down[i] >= strength && up[i] <= 100-strength:
+			outInteger[i] = -1
+		}
+	}
+	return outInteger[:len(up)], begIdx
+}
+
+// Vortex calls Vortex with the High, Low and Close columns of s.
+func (s OHLCV) Vortex(timePeriod int) ([]float64, []float64, int) {
+	s.mustValidate("High", "Low", "Close")
+	return Vortex(s.High, s.Low, s.Close, timePeriod, nil, nil)
+}
+
+// Chop calls Chop with the High, Low and Close columns of s.
+func (s OHLCV) Chop(timePeriod int) ([]float64, int) {
+	s.mustValidate("High", "Low", "Close")
+	return Chop(s.High, s.Low, s.Close, timePeriod, nil)
+}
+
+// ChaikinVolatility calls ChaikinVolatility with the High and Low columns of s.
+func (s OHLCV) ChaikinVolatility(emaPeriod, rocPeriod int) ([]float64, int) {
+	s.mustValidate("High", "Low")
+	return ChaikinVolatility(s.High, s.Low, emaPeriod, rocPeriod, nil)
+}
+
+// MassIndex calls MassIndex with the High and Low columns of s.
+func (s OHLCV) MassIndex(emaPeriod, sumPeriod int) ([]float64, int) {
+	s.mustValidate("High", "Low")
+	return MassIndex(s.High, s.Low, emaPeriod, sumPeriod, nil)
+}
+
+// HistoricalVolatility calls HistoricalVolatility with the Open, High, Low and Close columns of s.
+func (s OHLCV) HistoricalVolatility(timePeriod int, estimator VolatilityEstimator, periodsPerYear float64) ([]float64, int) {
+	columns := []string{"Open", "High", "Low", "Close"}
+	switch estimator {
+	case VolatilityCloseToClose:
+		columns = []string{"Close"}
+	case VolatilityParkinson:
+		columns = []string{"High", "Low", "Close"}
+	}
+	s.mustValidate(columns...)
+	return HistoricalVolatility(s.Open, s.High, s.Low, s.Close, timePeriod, estimator, periodsPerYear, nil)
+}
+
+// ElderRay calls ElderRay with the High, Low and Close columns of s.
+func (s OHLCV) ElderRay(timePeriod int) ([]float64, []float64, int) {
+	s.mustValidate("High", "Low", "Close")
+	return ElderRay(s.High, s.Low, s.Close, timePeriod, nil, nil)
+}
+
+// AroonTrend calls AroonTrend with the High and Low columns of s.
+func (s OHLCV) AroonTrend(timePeriod int, strength float64) ([]int, int) {
+	s.mustValidate("High", "Low")
+	return AroonTrend(s.High, s.Low, timePeriod, strength, nil)
+}
@@ -0,0 +1,132 @@
+package talib_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/phemmer/talib"
+)
+
+func TestVortex(t *testing.T) {
+	high := []float64{10, 11, 12}
+	low := []float64{9, 10, 11}
+	close := []float64{9.5, 10.5, 11.5}
+	plus, minus, begIdx := talib.Vortex(high, low, close, 2, nil, nil)
+	if begIdx != 2 || !closeTo([]float64{4.0 / 3}, plus) || !closeTo([]float64{0}, minus) {
+		t.Errorf("Expected %#v, %#v got %#v, %#v.", []float64{4.0 / 3}, []float64{0}, plus, minus)
+	}
+}
+
+func TestVortexAliased(t *testing.T) {
+	high := append([]float64(nil), refHigh...)
+	low := append([]float64(nil), refLow...)
+	expectedPlus, expectedMinus, _ := talib.Vortex(high, low, refClose, 2, nil, nil)
+	plus, minus, _ := talib.Vortex(high, low, refClose, 2, low, high)
+	if !closeTo(expectedPlus, plus) || !closeTo(expectedMinus, minus) {
+		t.Errorf("Expected %#v, %#v got %#v, %#v.", expectedPlus, expectedMinus, plus, minus)
+	}
+}
+
+func TestChop(t *testing.T) {
+	high := []float64{10, 11, 12, 11.5, 13}
+	low := []float64{9, 10, 10.5, 10, 11.5}
+	close := []float64{9.5, 10.8, 11, 10.2, 12.8}
+	// True range: 1.5, 1.5, 1.5, 2.8. High-low range: 12 - 10, 13 - 10.
+	out, begIdx := talib.Chop(high, low, close, 3, nil)
+	expected := []float64{100 * math.Log10(4.5/2) / math.Log10(3), 100 * math.Log10(5.8/3) / math.Log10(3)}
+	if begIdx != 3 || !closeTo(expected, out) {
+		t.Errorf("Expected %#v got %#v.", expected, out)
+	}
+
+	// There is no range.
+	flat := []float64{10, 10, 10, 10}
+	out, begIdx = talib.Chop(flat, flat, flat, 2, nil)
+	if begIdx != 2 || len(out) != 2 || !math.IsNaN(out[0]) || !math.IsNaN(out[1]) {
+		t.Errorf("Expected 2 NaN values got %#v.", out)
+	}
+}
+
+func TestUlcer(t *testing.T) {
+	// Drawdowns: -20%, 0%, -50%
+	out, begIdx := talib.Ulcer([]float64{10, 8, 10, 5}, 2, nil)
+	expected := []float64{math.Sqrt(200), math.Sqrt(1250)}
+	if begIdx != 2 || !closeTo(expected, out) {
+		t.Errorf("Expected %#v got %#v.", expected, out)
+	}
+}
+
+func TestChaikinVolatility(t *testing.T) {
+	high := []float64{1, 2, 4, 8}
+	low := []float64{0, 0, 0, 0}
+	// EMA(2) of the range: 1.5, 19/6, 115/18
+	out, begIdx := talib.ChaikinVolatility(high, low, 2, 1, nil)
+	expected := []float64{1000.0 / 9, 5800.0 / 57}
+	if begIdx != 2 || !closeTo(expected, out) {
+		t.Errorf("Expected %#v got %#v.", expected, out)
+	}
+}
+
+func TestMassIndex(t *testing.T) {
+	high := []float64{2, 3, 4, 5, 6, 7}
+	low := []float64{1, 2, 3, 4, 5, 6}
+	out, begIdx := talib.MassIndex(high, low, 2, 3, nil)
+	expected := []float64{3, 3}
+	if begIdx != 4 || !closeTo(expected, out) {
+		t.Errorf("Expected %#v got %#v.", expected, out)
+	}
+}
+
+func TestHistoricalVolatility(t *testing.T) {
+	// Each bar opens and closes at its low, with a log high-low range of 1.
+	open := []float64{1, 1, 1}
+	high := []float64{math.E, math.E, math.E}
+	low := []float64{1, 1, 1}
+	close := []float64{1, 1, 1}
+	k := 0.34 / (1.34 + 3)
+	tests := []struct {
+		estimator talib.VolatilityEstimator
+		expected  []float64
+		begIdx    int
+	}{
+		{talib.VolatilityParkinson, []float64{math.Sqrt(1 / (4 * math.Ln2)), math.Sqrt(1 / (4 * math.Ln2))}, 1},
+		{talib.VolatilityGarmanKlass, []float64{math.Sqrt(0.5), math.Sqrt(0.5)}, 1},
+		{talib.VolatilityRogersSatchell, []float64{1, 1}, 1},
+		{talib.VolatilityYangZhang, []float64{math.Sqrt(1 - k)}, 2},
+	}
+	for _, test := range tests {
+		out, begIdx := talib.HistoricalVolatility(open, high, low, close, 2, test.estimator, 1, nil)
+		if begIdx != test.begIdx || !closeTo(test.expected, out) {
+			t.Errorf("Expected %#v got %#v.", test.expected, out)
+		}
+	}
+
+	// Log returns: 1, -1, 1
+	close = []float64{1, math.E, 1, math.E}
+	out, begIdx := talib.HistoricalVolatility(nil, nil, nil, close, 2, talib.VolatilityCloseToClose, 4, nil)
+	expected := []float64{math.Sqrt(8), math.Sqrt(8)}
+	if begIdx != 2 || !closeTo(expected, out) {
+		t.Errorf("Expected %#v got %#v.", expected, out)
+	}
+}
+
+func TestElderRay(t *testing.T) {
+	high := []float64{11, 12, 13}
+	low := []float64{9, 8, 7}
+	close := []float64{10, 10, 10}
+	bull, bear, begIdx := talib.ElderRay(high, low, close, 2, nil, nil)
+	if begIdx != 1 || !closeTo([]float64{2, 3}, bull) || !closeTo([]float64{-2, -3}, bear) {
+		t.Errorf("Expected %#v, %#v got %#v, %#v.", []float64{2, 3}, []float64{-2, -3}, bull, bear)
+	}
+}
+
+func TestAroonTrend(t *testing.T) {
+	high := []float64{1, 2, 3, 4, 5, 4, 3, 2, 1}
+	low := []float64{0.5, 1.5, 2.5, 3.5, 4.5, 3.5, 2.5, 1.5, 0.5}
+	// Aroon Up: 100, 75, 50, 25, 0. Aroon Down: 0, 0, 100, 100, 100.
+	trend, begIdx := talib.AroonTrend(high, low, 4, 70, nil)
+	expected := []int{1, 1, 0, -1, -1}
+	if begIdx != 4 || !reflect.DeepEqual(expected, trend) {
+		t.Errorf("Expected %#v got %#v.", expected, trend)
+	}
+}
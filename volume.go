@@ -0,0 +1,220 @@
+package talib
+
+import "math"
+
+// Cmf returns the Chaikin Money Flow: the sum over timePeriod of the money flow volume, divided by the sum of the volume.
+// The money flow volume is the volume multiplied by the position of the close within the high-low range, from -1 at
+// the low to 1 at the high.
+func Cmf(high, low, close, volume []float64, timePeriod int, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(close))
+	}
+	begIdx := timePeriod - 1
+	if timePeriod < 1 || begIdx >= len(close) {
+		return outReal[:0], 0
+	}
+
+	// The volume is copied so that outReal may be the same slice as any of the inputs.
+	mfv, vol := make([]float64, len(close)), make([]float64, len(close))
+	for i := range close {
+		vol[i] = volume[i]
+		if r := high[i] - low[i]; r > 0 {
+			mfv[i] = ((close[i] - low[i]) - (high[i] - close[i])) / r * volume[i]
+		}
+	}
+	var sumMfv, sumVol float64
+	for i := range close {
+		sumMfv += mfv[i]
+		sumVol += vol[i]
+		if i >= timePeriod {
+			sumMfv -= mfv[i-timePeriod]
+			sumVol -= vol[i-timePeriod]
+		}
+		if i >= begIdx {
+			outReal[i-begIdx] = math.NaN()
+			if sumVol > 0 {
+				outReal[i-begIdx] = sumMfv / sumVol
+			}
+		}
+	}
+	return outReal[:len(close)-begIdx], begIdx
+}
+
+// ForceIndex returns Elder's Force Index: the exponential moving average over timePeriod of the change in close
+// multiplied by the volume.
+func ForceIndex(close, volume []float64, timePeriod int, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(close))
+	}
+	begIdx := timePeriod
+	if timePeriod < 1 || begIdx >= len(close) {
+		return outReal[:0], 0
+	}
+
+	force := make([]float64, len(close)-1)
+	for i := range force {
+		force[i] = (close[i+1] - close[i]) * volume[i+1]
+	}
+	return ema(force, timePeriod, 2/float64(timePeriod+1), outReal), begIdx
+}
+
+// Eom returns the Ease of Movement: the simple moving average over timePeriod of the change in the midpoint of the high
+// and low, divided by the box ratio (the volume divided by volumeDivisor, per unit of high-low range). volumeDivisor
+// scales the result, and is usually 100,000,000 for stocks.
+func Eom(high, low, volume []float64, timePeriod int, volumeDivisor float64, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(high))
+	}
+	begIdx := timePeriod
+	if timePeriod < 1 || begIdx >= len(high) {
+		return outReal[:0], 0
+	}
+
+	emv := make([]float64, len(high)-1)
+	for i := range emv {
+		distance := (high[i+1]+low[i+1])/2 - (high[i]+low[i])/2
+		if volume[i+1] > 0 {
+			emv[i] = distance * (high[i+1] - low[i+1]) / (volume[i+1] / volumeDivisor)
+		}
+	}
+	return sma(emv, timePeriod, outReal), begIdx
+}
+
+// Vpt returns the Volume Price Trend: the cumulative sum of the volume multiplied by the percentage change in close,
+// starting from 0.
+func Vpt(close, volume []float64, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(close))
+	}
+	var vpt float64
+	prev := math.NaN()
+	for i, c := range close {
+		if i > 0 {
+			vpt += volume[i] * (c - prev) / prev
+		}
+		prev = c
+		outReal[i] = vpt
+	}
+	return outReal[:len(close)], 0
+}
+
+// Kvo returns the Klinger Volume Oscillator, and its signal line.
+//
+// The volume force of each bar is the volume, signed by the direction of the change in high + low + close, and scaled
+// by how the high-low range compares with its cumulative range over the current trend. The oscillator is the
+// exponential moving average of the volume force over fastPeriod less that over slowPeriod, and the signal line is the
+// exponential moving average of the oscillator over signalPeriod. The usual periods are 34, 55 and 13.
+func Kvo(high, low, close, volume []float64, fastPeriod, slowPeriod, signalPeriod int, outKvo []float64, outKvoSignal []float64) ([]float64, []float64, int) {
+	if outKvo == nil {
+		outKvo = make([]float64, len(close))
+	}
+	if outKvoSignal == nil {
+		outKvoSignal = make([]float64, len(close))
+	}
+	begIdx := slowPeriod + signalPeriod - 1
+	if fastPeriod < 1 || slowPeriod < fastPeriod || signalPeriod < 1 || begIdx >= len(close) {
+		return outKvo[:0], outKvoSignal[:0], 0
+	}
+
+	vf := make([]float64, len(close)-1)
+	var trend, cm float64
+	for i := range vf {
+		dm, prevDm := high[i+1]-low[i+1], high[i]-low[i]
+		t := -1.0
+		if high[i+1]+low[i+1]+close[i+1] > high[i]+low[i]+close[i] {
+			t = 1
+		}
+		if t == trend {
+			cm += dm
+		} else {
+			cm = prevDm + dm
+		}
+		trend = t
+		if cm > 0 {
+			vf[i] = volume[i+1] * math.Abs(2*dm/cm-1) * trend * 100
+		}
+	}
+
+	fast := ema(vf, fastPeriod, 2/float64(fastPeriod+1), make([]float64, len(vf)))
+	slow := ema(vf, slowPeriod, 2/float64(slowPeriod+1), make([]float64, len(vf)))
+	kvo := make([]float64, len(slow))
+	for i := range kvo {
+		kvo[i] = fast[i+slowPeriod-fastPeriod] - slow[i]
+	}
+	signal := ema(kvo, signalPeriod, 2/float64(signalPeriod+1), outKvoSignal)
+	copy(outKvo, kvo[signalPeriod-1:])
+	return outKvo[:len(signal)], signal, begIdx
+}
+
+// volumeIndex returns the positive (up true) or negative volume index, starting from 1000. The index changes by the
+// percentage change in close on bars whose volume rises (positive) or falls (negative) from the previous bar.
+func volumeIndex(close, volume []float64, up bool, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(close))
+	}
+	index := 1000.0
+	var prev, prevVolume float64
+	for i, c := range close {
+		v := volume[i]
+		if i > 0 && (up && v > prevVolume || !up && v < prevVolume) {
+			index *= c / prev
+		}
+		prev, prevVolume = c, v
+		outReal[i] = index
+	}
+	return outReal[:len(close)], 0
+}
+
+// Pvi returns the Positive Volume Index, starting from 1000, which changes by the percentage change in close on bars
+// whose volume rises from the previous bar.
+func Pvi(close, volume []float64, outReal []float64) ([]float64, int) {
+	return volumeIndex(close, volume, true, outReal)
+}
+
+// Nvi returns the Negative Volume Index, starting from 1000, which changes by the percentage change in close on bars
+// whose volume falls from the previous bar.
+func Nvi(close, volume []float64, outReal []float64) ([]float64, int) {
+	return volumeIndex(close, volume, false, outReal)
+}
+
+// Cmf calls Cmf with the High, Low, Close and Volume columns of s.
+func (s OHLCV) Cmf(timePeriod int) ([]float64, int) {
+	s.mustValidate("High", "Low", "Close", "Volume")
+	return Cmf(s.High, s.Low, s.Close, s.Volume, timePeriod, nil)
+}
+
+// ForceIndex calls ForceIndex with the Close and Volume columns of s.
+func (s OHLCV) ForceIndex(timePeriod int) ([]float64, int) {
+	s.mustValidate("Close", "Volume")
+	return ForceIndex(s.Close, s.Volume, timePeriod, nil)
+}
+
+// Eom calls Eom with the High, Low and Volume columns of s.
+func (s OHLCV) Eom(timePeriod int, volumeDivisor float64) ([]float64, int) {
+	s.mustValidate("High", "Low", "Volume")
+	return Eom(s.High, s.Low, s.Volume, timePeriod, volumeDivisor, nil)
+}
+
+// Vpt calls Vpt with the Close and Volume columns of s.
+func (s OHLCV) Vpt() ([]float64, int) {
+	s.mustValidate("Close", "Volume")
+	return Vpt(s.Close, s.Volume, nil)
+}
+
+// Kvo calls Kvo with the High, Low, Close and Volume columns of s.
+func (s OHLCV) Kvo(fastPeriod, slowPeriod, signalPeriod int) ([]float64, []float64, int) {
+	s.mustValidate("High", "Low", "Close", "Volume")
+	return Kvo(s.High, s.Low, s.Close, s.Volume, fastPeriod, slowPeriod, signalPeriod, nil, nil)
+}
+
+// Pvi calls Pvi with the Close and Volume columns of s.
+func (s OHLCV) Pvi() ([]float64, int) {
+	s.mustValidate("Close", "Volume")
+	return Pvi(s.Close, s.Volume, nil)
+}
+
+// Nvi calls Nvi with the Close and Volume columns of s.
+func (s OHLCV) Nvi() ([]float64, int) {
+	s.mustValidate("Close", "Volume")
+	return Nvi(s.Close, s.Volume, nil)
+}
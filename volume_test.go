@@ -0,0 +1,192 @@
+package talib_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/phemmer/talib"
+)
+
+// closeTo reports whether the values are equal to within a relative error of 1e-9.
+func closeTo(expected, got []float64) bool {
+	return within(expected, got, 1e-9)
+}
+
+// within reports whether the values are equal to within the given relative error.
+func within(expected, got []float64, relErr float64) bool {
+	if len(expected) != len(got) {
+		return false
+	}
+	for i := range expected {
+		if math.Abs(expected[i]-got[i]) > relErr*math.Max(1, math.Abs(expected[i])) {
+			return false
+		}
+	}
+	return true
+}
+
+// Daily bars used for the reference tests. The expected values in those tests were calculated independently, following
+// the definitions and worked examples published by StockCharts, and are rounded to 6 significant figures.
+var (
+	refHigh = []float64{50.16, 50.58, 50.57, 50.17, 50.52, 50.59, 50.58, 50.71, 50, 48.85, 49.24, 49.44, 49.83, 49.95,
+		51.03, 51.31, 50.54, 49.37, 48.83, 48.24, 48.94, 48.93, 48.65, 48.7, 50.06, 50.52, 50.17, 50.66, 51.01, 51}
+	refLow = []float64{49.07, 49.12, 49.44, 48.16, 48.34, 49.22, 49.88, 48.86, 48.39, 48.49, 48.12, 48.52, 48.57, 49.21,
+		49.28, 49.99, 48.42, 48.29, 47.43, 47.1, 47.76, 48.07, 47.41, 47.59, 48.38, 48.98, 48.99, 49.13, 49.83, 50.05}
+	refClose = []float64{49.61, 50.46, 49.8, 48.83, 50, 50.24, 50.43, 49.52, 48.58, 48.75, 48.79, 49.05, 49.6, 49.64,
+		50.89, 50.07, 49.06, 48.71, 47.68, 48.14, 48.38, 48.14, 47.83, 48.55, 49.64, 49.81, 49.31, 50.5, 50.95, 50.41}
+	refVolume = []float64{918600, 1631300, 1194300, 1002800, 901300, 876300, 1685800, 1096100, 959600, 1137400, 1562800,
+		1094500, 1660400, 1797700, 1360400, 1894800, 1314000, 1547400, 1942000, 1860200, 1974100, 1556400, 1157500,
+		1440500, 1458000, 1701400, 1423300, 1180000, 1098700, 1404900}
+)
+
+func TestCmf(t *testing.T) {
+	high := []float64{10, 12, 11}
+	low := []float64{8, 10, 9}
+	close := []float64{9.5, 10, 11}
+	volume := []float64{100, 200, 300}
+	// Money flow volume: 50, -200, 300
+	out, begIdx := talib.Cmf(high, low, close, volume, 2, nil)
+	expected := []float64{-150.0 / 300, 100.0 / 500}
+	if begIdx != 1 || !closeTo(expected, out) {
+		t.Errorf("Expected %#v got %#v.", expected, out)
+	}
+}
+
+func TestCmfReference(t *testing.T) {
+	out, begIdx := talib.Cmf(refHigh, refLow, refClose, refVolume, 20, nil)
+	expected := []float64{0.0957255, 0.0959981, 0.00243218, 0.00454737, 0.0525519, 0.0603277, 0.0488793, -0.00462455,
+		0.0369314, 0.09379, 0.0651747}
+	if begIdx != 19 || !within(expected, out, 1e-5) {
+		t.Errorf("Expected %#v got %#v.", expected, out)
+	}
+}
+
+func TestCmfAliased(t *testing.T) {
+	high := []float64{10, 12, 11, 12}
+	low := []float64{8, 10, 9, 10}
+	close := []float64{9.5, 10, 11, 11}
+	volume := []float64{100, 200, 300, 100}
+	expected, _ := talib.Cmf(high, low, close, volume, 2, nil)
+	out, _ := talib.Cmf(high, low, close, volume, 2, volume)
+	if !closeTo(expected, out) {
+		t.Errorf("Expected %#v got %#v.", expected, out)
+	}
+}
+
+func TestForceIndex(t *testing.T) {
+	close := []float64{10, 11, 10.5, 12}
+	volume := []float64{100, 200, 100, 300}
+	// Force: 200, -50, 450. EMA(2): (200 - 50) / 2 = 75, 75 + 2/3 * (450 - 75) = 325
+	out, begIdx := talib.ForceIndex(close, volume, 2, nil)
+	expected := []float64{75, 325}
+	if begIdx != 2 || !closeTo(expected, out) {
+		t.Errorf("Expected %#v got %#v.", expected, out)
+	}
+}
+
+func TestForceIndexReference(t *testing.T) {
+	out, begIdx := talib.ForceIndex(refClose, refVolume, 13, nil)
+	expected := []float64{64375.3, 298107, 33558.3, -160827, -215222, -470227, -280810, -173011, -201657, -224110,
+		-43928.6, 189378, 203644, 72887.7, 263075, 296124, 145442}
+	if begIdx != 13 || !within(expected, out, 1e-5) {
+		t.Errorf("Expected %#v got %#v.", expected, out)
+	}
+}
+
+func TestEom(t *testing.T) {
+	high := []float64{10, 12, 13}
+	low := []float64{8, 10, 10}
+	volume := []float64{100, 200, 300}
+	// Distance: 2, 0.5. Box ratio: 200 / 2 = 100, 300 / 3 = 100.
+	out, begIdx := talib.Eom(high, low, volume, 2, 1, nil)
+	expected := []float64{(0.02 + 0.005) / 2}
+	if begIdx != 2 || !closeTo(expected, out) {
+		t.Errorf("Expected %#v got %#v.", expected, out)
+	}
+}
+
+func TestEomReference(t *testing.T) {
+	out, begIdx := talib.Eom(refHigh, refLow, refVolume, 14, 100000000, nil)
+	expected := []float64{-2.80669, -1.84586, -16.3768, -7.5909, -15.7737, -23.0917, -21.1523, -15.1955, -11.7213,
+		-9.90139, -1.10484, 0.520533, -1.67867, 0.121407, -1.13448, -3.09046}
+	if begIdx != 14 || !within(expected, out, 1e-5) {
+		t.Errorf("Expected %#v got %#v.", expected, out)
+	}
+}
+
+func TestVptVolumeIndex(t *testing.T) {
+	close := []float64{10, 11, 9.9, 9.9}
+	volume := []float64{100, 200, 100, 300}
+	out, _ := talib.Vpt(close, volume, nil)
+	expected := []float64{0, 20, 10, 10}
+	if !closeTo(expected, out) {
+		t.Errorf("Expected %#v got %#v.", expected, out)
+	}
+	out, _ = talib.Pvi(close, volume, nil)
+	expected = []float64{1000, 1100, 1100, 1100}
+	if !closeTo(expected, out) {
+		t.Errorf("Expected %#v got %#v.", expected, out)
+	}
+	out, _ = talib.Nvi(close, volume, nil)
+	expected = []float64{1000, 1000, 900, 900}
+	if !closeTo(expected, out) {
+		t.Errorf("Expected %#v got %#v.", expected, out)
+	}
+}
+
+func TestVptVolumeIndexReference(t *testing.T) {
+	tests := []struct {
+		name     string
+		fn       func(close, volume []float64, outReal []float64) ([]float64, int)
+		expected []float64
+	}{
+		{"Vpt", talib.Vpt, []float64{16116.8, 1829.51, 30306.5, 40096.9, 25206.9}},
+		{"Pvi", talib.Pvi, []float64{1038.52, 1038.52, 1038.52, 1038.52, 1027.51}},
+		{"Nvi", talib.Nvi, []float64{966.794, 957.09, 980.187, 988.921, 988.921}},
+	}
+	for _, test := range tests {
+		out, _ := test.fn(refClose, refVolume, nil)
+		if len(out) != len(refClose) || !within(test.expected, out[len(out)-5:], 1e-5) {
+			t.Errorf("%s: expected %#v at the end got %#v.", test.name, test.expected, out)
+		}
+
+		// The output may be the same slice as the volume.
+		volume := append([]float64(nil), refVolume...)
+		if aliased, _ := test.fn(refClose, volume, volume); !closeTo(out, aliased) {
+			t.Errorf("%s: expected %#v got %#v.", test.name, out, aliased)
+		}
+	}
+}
+
+func TestKvo(t *testing.T) {
+	n := 30
+	high, low, close, volume := make([]float64, n), make([]float64, n), make([]float64, n), make([]float64, n)
+	for i := range close {
+		close[i] = 100 + 5*math.Sin(float64(i)/3)
+		high[i], low[i], volume[i] = close[i]+1, close[i]-1, 1000
+	}
+	kvo, signal, begIdx := talib.Kvo(high, low, close, volume, 3, 6, 4, nil, nil)
+	if begIdx != 9 || len(kvo) != n-9 || len(signal) != n-9 {
+		t.Fatalf("Unexpected begIdx %d and lengths %d %d.", begIdx, len(kvo), len(signal))
+	}
+	for i := range kvo {
+		if math.IsNaN(kvo[i]) || math.IsNaN(signal[i]) {
+			t.Errorf("Unexpected NaN at %d.", i)
+		}
+	}
+}
+
+func TestKvoReference(t *testing.T) {
+	kvo, signal, begIdx := talib.Kvo(refHigh, refLow, refClose, refVolume, 5, 10, 4, nil, nil)
+	expectedKvo := []float64{1.98416e+07, 1.64534e+07, 2.32893e+07, 2.41364e+06, -1.67308e+07, -2.94252e+07, -3.81413e+07,
+		-2.09856e+07, -44935, -2.62042e+06, 514491, 4.65856e+06, 1.4488e+07, 4.5932e+06, 4.08092e+06, 7.79262e+06, 498855}
+	expectedSignal := []float64{6.65398e+06, 1.05737e+07, 1.566e+07, 1.03614e+07, -475452, -1.20553e+07, -2.24897e+07,
+		-2.18881e+07, -1.31508e+07, -8.93866e+06, -5.1574e+06, -1.23102e+06, 5.0566e+06, 4.87124e+06, 4.55511e+06,
+		5.85012e+06, 3.70961e+06}
+	if begIdx != 13 || !within(expectedKvo, kvo, 1e-5) {
+		t.Errorf("Expected %#v got %#v.", expectedKvo, kvo)
+	}
+	if !within(expectedSignal, signal, 1e-5) {
+		t.Errorf("Expected %#v got %#v.", expectedSignal, signal)
+	}
+}
@@ -0,0 +1,150 @@
+package talib
+
+import (
+	"math"
+	"time"
+)
+
+// vwapSum accumulates the volume weighted sums of the typical price.
+type vwapSum struct {
+	pv, pv2, v float64
+}
+
+func (s *vwapSum) add(high, low, close, volume, sign float64) {
+	p := (high + low + close) / 3
+	s.pv += sign * p * volume
+	s.pv2 += sign * p * p * volume
+	s.v += sign * volume
+}
+
+// vwap returns the volume weighted average price and its volume weighted standard deviation, or NaN if there is no
+// volume.
+func (s vwapSum) vwap() (vwap, stdDev float64) {
+	if s.v <= 0 {
+		return math.NaN(), math.NaN()
+	}
+	vwap = s.pv / s.v
+	return vwap, math.Sqrt(math.Max(s.pv2/s.v-vwap*vwap, 0))
+}
+
+// vwapSession calls fn with the sums for each bar since the start of its session, or ok false for bars outside of a
+// session.
+func vwapSession(times []time.Time, high, low, close, volume []float64, session Session, fn func(i int, s vwapSum, ok bool)) {
+	var sum vwapSum
+	var current time.Time
+	for i := range high {
+		start, _, ok := session.Bounds(times[i])
+		if !ok {
+			fn(i, sum, false)
+			continue
+		}
+		if !start.Equal(current) {
+			sum, current = vwapSum{}, start
+		}
+		sum.add(high[i], low[i], close[i], volume[i], 1)
+		fn(i, sum, true)
+	}
+}
+
+// Vwap returns the volume weighted average price: the average of the typical price ((high + low + close) / 3) weighted
+// by volume, since the start of the session containing each bar. Bars outside of a session are NaN.
+func Vwap(times []time.Time, high, low, close, volume []float64, session Session, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(high))
+	}
+	vwapSession(times, high, low, close, volume, session, func(i int, s vwapSum, ok bool) {
+		outReal[i] = math.NaN()
+		if ok {
+			outReal[i], _ = s.vwap()
+		}
+	})
+	return outReal[:len(high)], 0
+}
+
+// VwapBands returns Vwap, with bands at the given number of volume weighted standard deviations of the typical price
+// above and below it.
+func VwapBands(times []time.Time, high, low, close, volume []float64, session Session, nbDevUp, nbDevDn float64, outRealUpperBand []float64, outRealMiddleBand []float64, outRealLowerBand []float64) ([]float64, []float64, []float64, int) {
+	if outRealUpperBand == nil {
+		outRealUpperBand = make([]float64, len(high))
+	}
+	if outRealMiddleBand == nil {
+		outRealMiddleBand = make([]float64, len(high))
+	}
+	if outRealLowerBand == nil {
+		outRealLowerBand = make([]float64, len(high))
+	}
+	vwapSession(times, high, low, close, volume, session, func(i int, s vwapSum, ok bool) {
+		vwap, dev := math.NaN(), math.NaN()
+		if ok {
+			vwap, dev = s.vwap()
+		}
+		outRealUpperBand[i] = vwap + nbDevUp*dev
+		outRealMiddleBand[i] = vwap
+		outRealLowerBand[i] = vwap - nbDevDn*dev
+	})
+	n := len(high)
+	return outRealUpperBand[:n], outRealMiddleBand[:n], outRealLowerBand[:n], 0
+}
+
+// VwapRolling returns the average of the typical price weighted by volume, over the last timePeriod bars.
+func VwapRolling(high, low, close, volume []float64, timePeriod int, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(high))
+	}
+	begIdx := timePeriod - 1
+	if timePeriod < 1 || begIdx >= len(high) {
+		return outReal[:0], 0
+	}
+	var sum vwapSum
+	for i := range high {
+		sum.add(high[i], low[i], close[i], volume[i], 1)
+		if i >= timePeriod {
+			j := i - timePeriod
+			sum.add(high[j], low[j], close[j], volume[j], -1)
+		}
+		if i >= begIdx {
+			outReal[i-begIdx], _ = sum.vwap()
+		}
+	}
+	return outReal[:len(high)-begIdx], begIdx
+}
+
+// VwapAnchored returns the average of the typical price weighted by volume, since the bar at the anchor index.
+func VwapAnchored(high, low, close, volume []float64, anchor int, outReal []float64) ([]float64, int) {
+	if outReal == nil {
+		outReal = make([]float64, len(high))
+	}
+	if anchor < 0 || anchor >= len(high) {
+		return outReal[:0], 0
+	}
+	var sum vwapSum
+	for i := anchor; i < len(high); i++ {
+		sum.add(high[i], low[i], close[i], volume[i], 1)
+		outReal[i-anchor], _ = sum.vwap()
+	}
+	return outReal[:len(high)-anchor], anchor
+}
+
+// Vwap calls Vwap with the Time, High, Low, Close and Volume columns of s.
+func (s OHLCV) Vwap(session Session) ([]float64, int) {
+	s.mustValidate("Time", "High", "Low", "Close", "Volume")
+	return Vwap(s.Time, s.High, s.Low, s.Close, s.Volume, session, nil)
+}
+
+// VwapBands calls VwapBands with the Time, High, Low, Close and Volume columns of s.
+func (s OHLCV) VwapBands(session Session, nbDevUp, nbDevDn float64) ([]float64, []float64, []float64, int) {
+	s.mustValidate("Time", "High", "Low", "Close", "Volume")
+	return VwapBands(s.Time, s.High, s.Low, s.Close, s.Volume, session, nbDevUp, nbDevDn, nil, nil, nil)
+}
+
+// VwapRolling calls VwapRolling with the High, Low, Close and Volume columns of s.
+func (s OHLCV) VwapRolling(timePeriod int) ([]float64, int) {
+	s.mustValidate("High", "Low", "Close", "Volume")
+	return VwapRolling(s.High, s.Low, s.Close, s.Volume, timePeriod, nil)
+}
+
+// VwapAnchored calls VwapAnchored with the High, Low, Close and Volume columns of s.
+func (s OHLCV) VwapAnchored(anchor int) ([]float64, int) {
+	s.mustValidate("High", "Low", "Close", "Volume")
+	return VwapAnchored(s.High, s.Low, s.Close, s.Volume, anchor, nil)
+}
@@ -0,0 +1,51 @@
+package talib_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/phemmer/talib"
+)
+
+func TestVwap(t *testing.T) {
+	day := time.Date(2019, 3, 4, 0, 0, 0, 0, time.UTC)
+	times := []time.Time{
+		day.Add(9 * time.Hour),
+		day.Add(10 * time.Hour),
+		day.Add(11 * time.Hour),
+		day.Add(20 * time.Hour),
+		day.Add(33 * time.Hour),
+	}
+	price := []float64{10, 13, 16, 20, 30}
+	volume := []float64{1, 2, 3, 4, 5}
+	session := talib.Session{Start: 9 * time.Hour, End: 16 * time.Hour}
+
+	out, begIdx := talib.Vwap(times, price, price, price, volume, session, nil)
+	if begIdx != 0 || out[0] != 10 || out[1] != 12 || out[2] != 14 || !math.IsNaN(out[3]) || out[4] != 30 {
+		t.Errorf("Unexpected output %#v %d.", out, begIdx)
+	}
+
+	upper, middle, lower, _ := talib.VwapBands(times, price, price, price, volume, session, 2, 1, nil, nil, nil)
+	// The volume weighted variance of the first session at the third bar is (1*16 + 2*1 + 3*4) / 6 = 5.
+	if middle[2] != 14 || math.Abs(upper[2]-(14+2*math.Sqrt(5))) > 1e-9 || math.Abs(lower[2]-(14-math.Sqrt(5))) > 1e-9 {
+		t.Errorf("Unexpected bands %#v %#v %#v.", upper, middle, lower)
+	}
+}
+
+func TestVwapRolling(t *testing.T) {
+	price := []float64{10, 13, 16, 20}
+	volume := []float64{1, 2, 3, 1}
+	out, begIdx := talib.VwapRolling(price, price, price, volume, 2, nil)
+	expected := []float64{12, 14.8, 17}
+	if begIdx != 1 || !reflect.DeepEqual(expected, out) {
+		t.Errorf("Expected %#v got %#v.", expected, out)
+	}
+
+	out, begIdx = talib.VwapAnchored(price, price, price, volume, 2, nil)
+	expected = []float64{16, 17}
+	if begIdx != 2 || !reflect.DeepEqual(expected, out) {
+		t.Errorf("Expected %#v got %#v.", expected, out)
+	}
+}